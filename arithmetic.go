@@ -0,0 +1,74 @@
+package kurdical
+
+// AddDays returns the date n days after k. n may be negative.
+// The dialect, epoch and time of day of k are preserved.
+func (k KurdishDate) AddDays(n int) (KurdishDate, error) {
+	jdn, err := k.julianDay()
+	if err != nil {
+		return KurdishDate{}, err
+	}
+	return k.withJulianDay(jdn + n)
+}
+
+// AddMonths returns the date n Kurdish months after k. n may be negative.
+//
+// If the day of k does not exist in the resulting month, it is clamped to
+// the last day of that month: day 31 becomes day 30 in months 7 to 11, and
+// Resheme 30 becomes Resheme 29 in a non-leap year.
+// The dialect, epoch and time of day of k are preserved.
+func (k KurdishDate) AddMonths(n int) (KurdishDate, error) {
+	if _, err := k.julianDay(); err != nil {
+		return KurdishDate{}, err
+	}
+	sYear, month, day := addMonthsYMD(k.Year-epochOffsets[k.Epoch], k.Month, k.Day, n, k.Model)
+	jdn, err := toJDN(sYear, month, day, k.Model)
+	if err != nil {
+		return KurdishDate{}, &ErrorInvalidYear{Year: sYear + epochOffsets[k.Epoch]}
+	}
+	return k.withJulianDay(jdn)
+}
+
+// AddYears returns the date n Kurdish years after k. n may be negative.
+// It follows the same clamping rule as AddMonths, so Resheme 30 of a leap
+// year becomes Resheme 29 when the resulting year is not a leap year.
+func (k KurdishDate) AddYears(n int) (KurdishDate, error) {
+	return k.AddMonths(n * 12)
+}
+
+// Sub returns the number of days from u to k, that is k - u.
+// The two dates may use different epochs; time of day is ignored.
+func (k KurdishDate) Sub(u KurdishDate) (int, error) {
+	kd, err := k.julianDay()
+	if err != nil {
+		return 0, err
+	}
+	ud, err := u.julianDay()
+	if err != nil {
+		return 0, err
+	}
+	return kd - ud, nil
+}
+
+// julianDay validates k and returns its Julian Day Number.
+func (k KurdishDate) julianDay() (int, error) {
+	if _, _, _, err := KurdishToGregorianDateModel(k.Year, k.Month, k.Day, k.Epoch, k.Model); err != nil {
+		return 0, err
+	}
+	return toJDN(k.Year-epochOffsets[k.Epoch], k.Month, k.Day, k.Model)
+}
+
+// withJulianDay returns k moved to the given Julian Day Number,
+// keeping its dialect, epoch, time of day and location.
+func (k KurdishDate) withJulianDay(jdn int) (KurdishDate, error) {
+	gy, gm, gd := d2g(jdn)
+	if _, _, _, err := fromJDN(jdn, k.Model); err != nil {
+		return KurdishDate{}, &ErrorInvalidYear{Year: gy - 621 + epochOffsets[k.Epoch]}
+	}
+	r, err := GregorianToKurdishDateModel(gy, gm, gd, k.Dialect, k.Epoch, k.Model)
+	if err != nil {
+		return KurdishDate{}, err
+	}
+	r.Hour, r.Minute, r.Second, r.Nanosecond = k.Hour, k.Minute, k.Second, k.Nanosecond
+	r.Location = k.Location
+	return r, nil
+}
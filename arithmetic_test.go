@@ -0,0 +1,93 @@
+package kurdical
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAddDays(t *testing.T) {
+	tehran := time.FixedZone("IRST", 3*3600+1800)
+	start := GregorianToKurdish(time.Date(2023, 3, 21, 8, 30, 0, 0, tehran), Kurmanji, FallOfNineveh)
+	for _, n := range []int{-1000, -366, -1, 0, 1, 31, 186, 365, 366, 1000} {
+		result, err := start.AddDays(n)
+		if err != nil {
+			t.Fatalf("AddDays(%d) unexpected error: %v", n, err)
+		}
+		expected := GregorianToKurdish(time.Date(2023, 3, 21+n, 8, 30, 0, 0, tehran), Kurmanji, FallOfNineveh)
+		if result != expected {
+			t.Errorf("AddDays(%d) = %v, expected %v", n, result, expected)
+		}
+	}
+}
+
+func TestAddMonths(t *testing.T) {
+	tests := []struct {
+		name     string
+		year     int
+		month    int
+		day      int
+		n        int
+		expected [3]int
+	}{
+		{"Same day next month", 2723, 1, 15, 1, [3]int{2723, 2, 15}},
+		{"Day 31 into 30-day month", 2723, 6, 31, 1, [3]int{2723, 7, 30}},
+		{"Day 31 into 29-day Resheme", 2723, 1, 31, 11, [3]int{2723, 12, 29}},
+		{"Day 30 into leap Resheme", 2724, 11, 30, 1, [3]int{2724, 12, 30}},
+		{"Across year end", 2723, 12, 29, 1, [3]int{2724, 1, 29}},
+		{"Backwards across year start", 2724, 1, 31, -1, [3]int{2723, 12, 29}},
+		{"Twelve months", 2723, 5, 10, 12, [3]int{2724, 5, 10}},
+		{"Many months backwards", 2723, 5, 10, -30, [3]int{2720, 11, 10}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := KurdishDate{Year: tt.year, Month: tt.month, Day: tt.day, Dialect: Sorani, Epoch: MedianKingdom}
+			result, err := k.AddMonths(tt.n)
+			if err != nil {
+				t.Fatalf("AddMonths() unexpected error: %v", err)
+			}
+			if got := [3]int{result.Year, result.Month, result.Day}; got != tt.expected {
+				t.Errorf("AddMonths(%d) = %v, expected %v", tt.n, got, tt.expected)
+			}
+			if result.Dialect != Sorani || result.Epoch != MedianKingdom || result.MonthName == "" {
+				t.Errorf("AddMonths() lost dialect or epoch: %+v", result)
+			}
+		})
+	}
+}
+
+func TestAddYears(t *testing.T) {
+	leapDay := KurdishDate{Year: 2724, Month: 12, Day: 30, Dialect: Hawrami, Epoch: MedianKingdom}
+	result, err := leapDay.AddYears(1)
+	if err != nil {
+		t.Fatalf("AddYears() unexpected error: %v", err)
+	}
+	if result.Year != 2725 || result.Month != 12 || result.Day != 29 {
+		t.Errorf("AddYears(1) = %d-%d-%d, expected 2725-12-29", result.Year, result.Month, result.Day)
+	}
+	if _, err := leapDay.AddYears(10000); err == nil {
+		t.Errorf("AddYears(10000) expected error, got none")
+	}
+	invalid := KurdishDate{Year: 2723, Month: 7, Day: 31, Epoch: MedianKingdom}
+	if _, err := invalid.AddYears(1); err == nil {
+		t.Errorf("AddYears() on invalid date expected error, got none")
+	}
+}
+
+func TestSub(t *testing.T) {
+	a := KurdishDate{Year: 2724, Month: 1, Day: 1, Epoch: MedianKingdom}
+	b := KurdishDate{Year: 2635, Month: 1, Day: 1, Epoch: FallOfNineveh}
+	days, err := a.Sub(b)
+	if err != nil {
+		t.Fatalf("Sub() unexpected error: %v", err)
+	}
+	if days != 365 {
+		t.Errorf("Sub() = %d, expected 365", days)
+	}
+	if days, _ := b.Sub(a); days != -365 {
+		t.Errorf("Sub() = %d, expected -365", days)
+	}
+	if _, err := a.Sub(KurdishDate{Year: 2723, Month: 13, Day: 1}); err == nil {
+		t.Errorf("Sub() expected error for invalid date, got none")
+	}
+}
@@ -0,0 +1,167 @@
+package kurdical
+
+import "math"
+
+// Longitudes, in degrees east, of the reference meridians of the
+// astronomical calendar models.
+const (
+	tehranMeridian    = 52.5 // Iran Standard Time
+	kurdistanMeridian = 45.0 // UTC+3, between Erbil (44.0°E) and Slemani (45.4°E)
+)
+
+// Gregorian years covered by the equinox tables of Meeus.
+const (
+	equinoxMinYear = -1000
+	equinoxMaxYear = 3000
+)
+
+// equinoxTerms holds the periodic terms A, B, C of the March equinox
+// (Meeus, Astronomical Algorithms, table 27.C).
+var equinoxTerms = [...][3]float64{
+	{485, 324.96, 1934.136},
+	{203, 337.23, 32964.467},
+	{199, 342.08, 20.186},
+	{182, 27.85, 445267.112},
+	{156, 73.14, 45036.886},
+	{136, 171.52, 22518.443},
+	{77, 222.54, 65928.934},
+	{74, 296.72, 3034.906},
+	{70, 243.58, 9037.513},
+	{58, 119.81, 33718.147},
+	{52, 297.17, 150.678},
+	{50, 21.02, 2281.226},
+	{45, 247.54, 29929.562},
+	{44, 325.15, 31555.956},
+	{29, 60.93, 4443.417},
+	{18, 155.12, 67555.328},
+	{17, 288.79, 4562.452},
+	{16, 198.04, 62894.029},
+	{14, 199.76, 31436.921},
+	{12, 95.39, 14577.848},
+	{12, 287.11, 31931.756},
+	{12, 320.81, 34777.259},
+	{9, 227.73, 1222.114},
+	{8, 15.45, 16859.074},
+}
+
+// marchEquinox returns the instant of the March equinox of a Gregorian year
+// as a Julian Ephemeris Day (Meeus, chapter 27). The result is accurate to
+// about a minute for years near the present.
+func marchEquinox(gy int) float64 {
+	var jde0 float64
+	if gy < 1000 {
+		y := float64(gy) / 1000
+		jde0 = 1721139.29189 + 365242.13740*y + 0.06134*y*y + 0.00111*y*y*y - 0.00071*y*y*y*y
+	} else {
+		y := float64(gy-2000) / 1000
+		jde0 = 2451623.80984 + 365242.37404*y + 0.05169*y*y - 0.00411*y*y*y - 0.00057*y*y*y*y
+	}
+
+	t := (jde0 - 2451545.0) / 36525
+	w := radians(35999.373*t - 2.47)
+	dl := 1 + 0.0334*math.Cos(w) + 0.0007*math.Cos(2*w)
+	s := 0.0
+	for _, term := range equinoxTerms {
+		s += term[0] * math.Cos(radians(term[1]+term[2]*t))
+	}
+	return jde0 + 0.00001*s/dl
+}
+
+// deltaT returns the difference TT - UT in seconds for a Gregorian year,
+// using the polynomial expressions of Espenak and Meeus.
+func deltaT(gy int) float64 {
+	y := float64(gy) + 0.2 // the March equinox falls about a fifth into the year
+	switch {
+	case y < -500:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u
+	case y < 500:
+		u := y / 100
+		return poly(u, 10583.6, -1014.41, 33.78311, -5.952053, -0.1798452, 0.022174192, 0.0090316521)
+	case y < 1600:
+		u := (y - 1000) / 100
+		return poly(u, 1574.2, -556.01, 71.23472, 0.319781, -0.8503463, -0.005050998, 0.0083572073)
+	case y < 1700:
+		t := y - 1600
+		return poly(t, 120, -0.9808, -0.01532, 1.0/7129)
+	case y < 1800:
+		t := y - 1700
+		return poly(t, 8.83, 0.1603, -0.0059285, 0.00013336, -1.0/1174000)
+	case y < 1860:
+		t := y - 1800
+		return poly(t, 13.72, -0.332447, 0.0068612, 0.0041116, -0.00037436, 0.0000121272, -0.0000001699, 0.000000000875)
+	case y < 1900:
+		t := y - 1860
+		return poly(t, 7.62, 0.5737, -0.251754, 0.01680668, -0.0004473624, 1.0/233174)
+	case y < 1920:
+		t := y - 1900
+		return poly(t, -2.79, 1.494119, -0.0598939, 0.0061966, -0.000197)
+	case y < 1941:
+		t := y - 1920
+		return poly(t, 21.20, 0.84493, -0.076100, 0.0020936)
+	case y < 1961:
+		t := y - 1950
+		return poly(t, 29.07, 0.407, -1.0/233, 1.0/2547)
+	case y < 1986:
+		t := y - 1975
+		return poly(t, 45.45, 1.067, -1.0/260, -1.0/718)
+	case y < 2005:
+		t := y - 2000
+		return poly(t, 63.86, 0.3345, -0.060374, 0.0017275, 0.000651814, 0.00002373599)
+	case y < 2050:
+		t := y - 2000
+		return poly(t, 62.92, 0.32217, 0.005589)
+	case y < 2150:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u - 0.5628*(2150-y)
+	}
+	u := (y - 1820) / 100
+	return -20 + 32*u*u
+}
+
+// equationOfTime returns apparent minus mean solar time, in days, at the
+// given Julian Ephemeris Day (Meeus, chapter 28, low accuracy form).
+func equationOfTime(jde float64) float64 {
+	t := (jde - 2451545.0) / 36525
+	l0 := radians(280.46646 + 36000.76983*t)
+	m := radians(357.52911 + 35999.05029*t)
+	e := 0.016708634 - 0.000042037*t
+	eps := radians(23.439291 - 0.0130042*t)
+	y := math.Tan(eps/2) * math.Tan(eps/2)
+
+	eq := y*math.Sin(2*l0) - 2*e*math.Sin(m) + 4*e*y*math.Sin(m)*math.Cos(2*l0) -
+		0.5*y*y*math.Sin(4*l0) - 1.25*e*e*math.Sin(2*m)
+	return eq / (2 * math.Pi)
+}
+
+// newrozJDN returns the Julian Day Number of Newroz of a Gregorian year
+// for the given reference meridian: the day of the March equinox if it
+// happens before true noon at that meridian, otherwise the following day.
+func newrozJDN(gy int, longitude float64) int {
+	jde := marchEquinox(gy)
+	ut := jde - deltaT(gy)/86400
+
+	// Julian days begin at Greenwich noon, so local civil day N spans
+	// [N - 0.5, N + 0.5) once shifted by the meridian.
+	offset := longitude / 360
+	day := int(math.Floor(ut + offset + 0.5))
+	noon := float64(day) - offset - equationOfTime(jde)
+	if ut < noon {
+		return day
+	}
+	return day + 1
+}
+
+// radians converts degrees to radians.
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+// poly evaluates the polynomial with the given coefficients, lowest first, at x.
+func poly(x float64, coeffs ...float64) float64 {
+	r := 0.0
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		r = r*x + coeffs[i]
+	}
+	return r
+}
@@ -0,0 +1,106 @@
+package kurdical
+
+import "sort"
+
+// Compare compares the dates k and u. If k is before u it returns -1;
+// if k is after u it returns +1; if they are the same it returns 0.
+//
+// Dates are compared by their day, whatever their epoch or dialect, and
+// then by time of day. Dates in different calendar models are compared by
+// the day they fall on, as one day can have different dates in them. The
+// Location of the dates is not taken into account: compare dates in the
+// same location, or convert them with KurdishToGregorian to compare
+// instants.
+func (k KurdishDate) Compare(u KurdishDate) int {
+	if c := k.compareDay(u); c != 0 {
+		return c
+	}
+	switch {
+	case k.Hour != u.Hour:
+		return sign(k.Hour - u.Hour)
+	case k.Minute != u.Minute:
+		return sign(k.Minute - u.Minute)
+	case k.Second != u.Second:
+		return sign(k.Second - u.Second)
+	}
+	return sign(k.Nanosecond - u.Nanosecond)
+}
+
+// Before reports whether k is before u.
+func (k KurdishDate) Before(u KurdishDate) bool {
+	return k.Compare(u) < 0
+}
+
+// After reports whether k is after u.
+func (k KurdishDate) After(u KurdishDate) bool {
+	return k.Compare(u) > 0
+}
+
+// Equal reports whether k and u are the same day and time of day.
+// Unlike ==, it ignores the dialect, and dates in different epochs or
+// calendar models are equal when they name the same day.
+func (k KurdishDate) Equal(u KurdishDate) bool {
+	return k.Compare(u) == 0
+}
+
+// DayKey returns a compact integer that orders dates by day, such as
+// 27230101 for 1 Khakelive 2723. The year is always counted in the
+// MedianKingdom epoch, so keys of dates in different epochs can be compared.
+// The key is that of the date in its own calendar model: keys of dates in
+// different models cannot be compared, as one day can have different
+// dates in them.
+func (k KurdishDate) DayKey() int {
+	year := k.Year - epochOffsets[k.Epoch] + epochOffsets[MedianKingdom]
+	return year*10000 + k.Month*100 + k.Day
+}
+
+// ByDate implements sort.Interface for a slice of KurdishDate,
+// ordering them with Compare.
+type ByDate []KurdishDate
+
+func (s ByDate) Len() int           { return len(s) }
+func (s ByDate) Less(i, j int) bool { return s[i].Compare(s[j]) < 0 }
+func (s ByDate) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// SortDates sorts dates in increasing order. The sort is not stable;
+// use sort.Stable(ByDate(dates)) to keep the order of equal dates.
+func SortDates(dates []KurdishDate) {
+	sort.Sort(ByDate(dates))
+}
+
+// compareDay compares the days of k and u and returns -1, 0 or +1. Dates
+// in different calendar models are compared by Julian Day Number, unless
+// one of them is not a valid date.
+func (k KurdishDate) compareDay(u KurdishDate) int {
+	if k.Model != u.Model {
+		kd, kerr := k.julianDay()
+		ud, uerr := u.julianDay()
+		if kerr == nil && uerr == nil {
+			return sign(kd - ud)
+		}
+	}
+	return compareYMD(k.Year-epochOffsets[k.Epoch], k.Month, k.Day,
+		u.Year-epochOffsets[u.Epoch], u.Month, u.Day)
+}
+
+// compareYMD compares two year, month, day triples and returns -1, 0 or +1.
+func compareYMD(y1, m1, d1, y2, m2, d2 int) int {
+	switch {
+	case y1 != y2:
+		return sign(y1 - y2)
+	case m1 != m2:
+		return sign(m1 - m2)
+	}
+	return sign(d1 - d2)
+}
+
+// sign returns -1, 0 or +1 according to the sign of x.
+func sign(x int) int {
+	switch {
+	case x < 0:
+		return -1
+	case x > 0:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,82 @@
+package kurdical
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestCompare(t *testing.T) {
+	median := KurdishDate{Year: 2723, Month: 1, Day: 1, Dialect: Sorani, Epoch: MedianKingdom, MonthName: "خاکه‌لێوه"}
+	nineveh := KurdishDate{Year: 2635, Month: 1, Day: 1, Dialect: Kurmanji, Epoch: FallOfNineveh, MonthName: "نیسان"}
+	later := median
+	later.Hour = 1
+	tomorrow := KurdishDate{Year: 2723, Month: 1, Day: 2, Epoch: MedianKingdom}
+
+	tests := []struct {
+		name     string
+		a        KurdishDate
+		b        KurdishDate
+		expected int
+	}{
+		{"Same day across epochs and dialects", median, nineveh, 0},
+		{"Time of day", median, later, -1},
+		{"Next day", tomorrow, nineveh, 1},
+		{"Previous year", KurdishDate{Year: 2722, Month: 12, Day: 29, Epoch: MedianKingdom}, median, -1},
+		// 2025-03-20 is the last day of 2724 in the arithmetic calendar and
+		// Newroz of 2725 at the Kurdistan meridian.
+		{"Same day across models", KurdishDate{Year: 2724, Month: 12, Day: 30, Epoch: MedianKingdom, Model: Arithmetic},
+			KurdishDate{Year: 2725, Month: 1, Day: 1, Epoch: MedianKingdom, Model: AstronomicalKurdistan}, 0},
+		{"Same date across models", KurdishDate{Year: 2725, Month: 1, Day: 1, Epoch: MedianKingdom, Model: Arithmetic},
+			KurdishDate{Year: 2725, Month: 1, Day: 1, Epoch: MedianKingdom, Model: AstronomicalKurdistan}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.a.Compare(tt.b); result != tt.expected {
+				t.Errorf("Compare() = %d, expected %d", result, tt.expected)
+			}
+			if result := tt.b.Compare(tt.a); result != -tt.expected {
+				t.Errorf("reverse Compare() = %d, expected %d", result, -tt.expected)
+			}
+			if tt.a.Equal(tt.b) != (tt.expected == 0) ||
+				tt.a.Before(tt.b) != (tt.expected < 0) ||
+				tt.a.After(tt.b) != (tt.expected > 0) {
+				t.Errorf("Equal/Before/After disagree with Compare() = %d", tt.expected)
+			}
+		})
+	}
+}
+
+func TestDayKey(t *testing.T) {
+	k := KurdishDate{Year: 2635, Month: 1, Day: 1, Epoch: FallOfNineveh}
+	if key := k.DayKey(); key != 27230101 {
+		t.Errorf("DayKey() = %d, expected 27230101", key)
+	}
+}
+
+func TestSortDates(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	rng := rand.New(rand.NewSource(1))
+	dates := make([]KurdishDate, 200)
+	for i := range dates {
+		g := start.Add(time.Duration(rng.Int63n(int64(5 * 365 * 24 * time.Hour))))
+		dates[i] = GregorianToKurdish(g, Dialect(rng.Intn(5)), Epoch(rng.Intn(2)))
+	}
+
+	SortDates(dates)
+	if !sort.IsSorted(ByDate(dates)) {
+		t.Fatalf("SortDates() did not sort the dates")
+	}
+	for i := 1; i < len(dates); i++ {
+		a, _ := KurdishToGregorian(dates[i-1])
+		b, _ := KurdishToGregorian(dates[i])
+		if a.After(b) {
+			t.Errorf("dates[%d] = %v is after dates[%d] = %v", i-1, a, i, b)
+		}
+		if dates[i-1].DayKey() > dates[i].DayKey() {
+			t.Errorf("DayKey() of dates[%d] is after dates[%d]", i-1, i)
+		}
+	}
+}
@@ -1,31 +1,23 @@
 package kurdical
 
-import (
-	"time"
-)
-
 var (
 	breaks = [...]int{-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181, 1210,
 		1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178}
 )
 
 // gregorianToSolarHijri converts Gregorian date to Solar Hijri.
-func gregorianToSolarHijri(gYear, gMonth, gDay int) (sYear, sMonth, sDay int) {
-	jy, jm, jd, err := toJalaali(gYear, time.Month(gMonth), gDay)
-	if err != nil {
-		// For invalid dates, return 0
-		return 0, 0, 0
-	}
-	return jy, int(jm), jd
+func gregorianToSolarHijri(gYear, gMonth, gDay int, model CalendarModel) (sYear, sMonth, sDay int, err error) {
+	return fromJDN(g2d(gYear, gMonth, gDay), model)
 }
 
 // solarHijriToGregorian converts Solar Hijri date to Gregorian.
-func solarHijriToGregorian(sYear, sMonth, sDay int) (gYear, gMonth, gDay int) {
-	gy, gm, gd, err := toGregorian(sYear, Month(sMonth), sDay)
+func solarHijriToGregorian(sYear, sMonth, sDay int, model CalendarModel) (gYear, gMonth, gDay int, err error) {
+	jdn, err := toJDN(sYear, sMonth, sDay, model)
 	if err != nil {
-		return 0, 0, 0
+		return 0, 0, 0, err
 	}
-	return gy, int(gm), gd
+	gy, gm, gd := d2g(jdn)
+	return gy, gm, gd, nil
 }
 
 // Month represents a month of the year.
@@ -46,58 +38,68 @@ const (
 	Resheme                     // ره‌شه‌مێ
 )
 
-// toJalaali converts Gregorian to Jalaali date.
-func toJalaali(gregorianYear int, gregorianMonth time.Month, gregorianDay int) (int, Month, int, error) {
-	jy, jm, jd, err := d2j(g2d(gregorianYear, int(gregorianMonth), gregorianDay))
-	return jy, Month(jm), jd, err
-}
-
-// toGregorian converts Jalaali to Gregorian date.
-func toGregorian(jalaaliYear int, jalaaliMonth Month, jalaaliDay int) (int, time.Month, int, error) {
-	jdn, err := j2d(jalaaliYear, int(jalaaliMonth), jalaaliDay)
-	if err != nil {
-		return 0, 0, 0, err
-	}
-	gy, gm, gd := d2g(jdn)
-	return gy, time.Month(gm), gd, nil
-}
-
-func j2d(jy, jm, jd int) (jdn int, err error) {
-	_, gy, march, err := jalCal(jy)
+// toJDN converts a Solar Hijri date to a Julian Day Number.
+func toJDN(sYear, sMonth, sDay int, model CalendarModel) (int, error) {
+	start, err := yearStart(sYear, model)
 	if err != nil {
 		return 0, err
 	}
-	return g2d(gy, 3, march) + (jm-1)*31 - div(jm, 7)*(jm-7) + jd - 1, nil
+	return start + (sMonth-1)*31 - div(sMonth, 7)*(sMonth-7) + sDay - 1, nil
 }
 
-func d2j(jdn int) (int, int, int, error) {
+// fromJDN converts a Julian Day Number to a Solar Hijri date.
+func fromJDN(jdn int, model CalendarModel) (int, int, int, error) {
 	gy, _, _ := d2g(jdn)
 	jy := gy - 621
-	leap, _, march, err := jalCal(jy)
-	jdn1f := g2d(gy, 3, march)
-
+	start, err := yearStart(jy, model)
 	if err != nil {
-		return 0, 0, 0, err
+		// The last supported year ends in the Gregorian year in which the
+		// next, unsupported one begins.
+		if start, _ = nextYearStart(jy-1, model); start == 0 || jdn >= start {
+			return 0, 0, 0, err
+		}
+	}
+	if jdn < start {
+		jy--
+		if start, err = yearStart(jy, model); err != nil {
+			return 0, 0, 0, err
+		}
+	}
+
+	k := jdn - start
+	if k <= 185 {
+		return jy, 1 + div(k, 31), mod(k, 31) + 1, nil
 	}
+	k -= 186
+	return jy, 7 + div(k, 30), mod(k, 30) + 1, nil
+}
 
-	k := jdn - jdn1f
-	if k >= 0 {
-		if k <= 185 {
-			jm := 1 + div(k, 31)
-			jd := mod(k, 31) + 1
-			return jy, jm, jd, nil
+// yearStart returns the Julian Day Number of the first day of a
+// Solar Hijri year in the given calendar model.
+func yearStart(jy int, model CalendarModel) (int, error) {
+	switch model {
+	case Arithmetic:
+		_, gy, march, err := jalCal(jy)
+		if err != nil {
+			return 0, err
 		}
-		k -= 186
-	} else {
-		jy--
-		k += 179
-		if leap == 1 {
-			k++
+		return g2d(gy, 3, march), nil
+	case Proleptic:
+		if jy < prolepticMinYear || jy > prolepticMaxYear {
+			return 0, &ErrorInvalidYear{jy}
+		}
+		return g2d(jy+621, 3, jalCalProleptic(jy)), nil
+	case Astronomical, AstronomicalKurdistan:
+		if jy < astronomicalMinYear || jy > astronomicalMaxYear {
+			return 0, &ErrorInvalidYear{jy}
 		}
+		longitude := tehranMeridian
+		if model == AstronomicalKurdistan {
+			longitude = kurdistanMeridian
+		}
+		return newrozJDN(jy+621, longitude), nil
 	}
-	jm := 7 + div(k, 30)
-	jd := mod(k, 30) + 1
-	return jy, jm, jd, nil
+	return 0, &ErrorInvalidModel{Model: model}
 }
 
 func jalCal(jy int) (int, int, int, error) {
@@ -139,6 +141,31 @@ func jalCal(jy int) (int, int, int, error) {
 	return leap, gy, march, nil
 }
 
+// jalCalProleptic returns the day in March of the first day of a Solar Hijri
+// year, like jalCal, but continues the 33-year arithmetic cycle before the
+// first and after the last entry of the breaks table.
+func jalCalProleptic(jy int) int {
+	bl := len(breaks)
+	if jy >= breaks[0] && jy < breaks[bl-1] {
+		_, _, march, _ := jalCal(jy)
+		return march
+	}
+
+	gy, leapJ, jp := jy+621, -14, breaks[0]
+	if jy >= breaks[bl-1] {
+		for i := 1; i < bl; i++ {
+			jump := breaks[i] - jp
+			leapJ += div(jump, 33)*8 + div(mod(jump, 33), 4)
+			jp = breaks[i]
+		}
+	}
+	n := jy - jp
+
+	leapJ += floorDiv(n, 33)*8 + floorDiv(floorMod(n, 33)+3, 4)
+	leapG := floorDiv(gy, 4) - floorDiv((floorDiv(gy, 100)+1)*3, 4) - 150
+	return 20 + leapJ - leapG
+}
+
 func g2d(gy, gm, gd int) int {
 	d := div((gy+div(gm-8, 6)+100100)*1461, 4) +
 		div(153*mod(gm+9, 12)+2, 5) +
@@ -165,8 +192,45 @@ func mod(a, b int) int {
 	return a % b
 }
 
-// isSolarHijriLeap determines if a Solar Hijri year is leap.
-func isSolarHijriLeap(year int) bool {
-	leap, _, _, err := jalCal(year)
-	return err == nil && leap == 1
+// floorDiv returns a / b rounded towards negative infinity.
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+// floorMod returns a - b*floorDiv(a, b).
+func floorMod(a, b int) int {
+	return a - b*floorDiv(a, b)
+}
+
+// isSolarHijriLeap determines if a Solar Hijri year is leap, that is if
+// it is 366 days long. The leap count from jalCal is not used because it
+// is unreliable in the years just before a break.
+func isSolarHijriLeap(year int, model CalendarModel) bool {
+	start, err := yearStart(year, model)
+	if err != nil {
+		return false
+	}
+	next, err := nextYearStart(year, model)
+	return err == nil && next-start == 366
+}
+
+// nextYearStart returns the Julian Day Number of the first day after a
+// Solar Hijri year. The last year of a model's range ends where the model
+// would begin the following year: the arithmetic cycle continues past the
+// last break, and the equinox is computed one year past its table.
+func nextYearStart(jy int, model CalendarModel) (int, error) {
+	switch {
+	case model == Arithmetic && jy == breaks[len(breaks)-1]-1,
+		model == Proleptic && jy == prolepticMaxYear:
+		return g2d(jy+622, 3, jalCalProleptic(jy+1)), nil
+	case model == Astronomical && jy == astronomicalMaxYear:
+		return newrozJDN(jy+622, tehranMeridian), nil
+	case model == AstronomicalKurdistan && jy == astronomicalMaxYear:
+		return newrozJDN(jy+622, kurdistanMeridian), nil
+	}
+	return yearStart(jy+1, model)
 }
@@ -0,0 +1,55 @@
+package kurdical
+
+import "testing"
+
+func TestWeekdayName(t *testing.T) {
+	tests := []struct {
+		dialect  Dialect
+		weekday  int
+		expected string
+	}{
+		{Sorani, 1, "شەممە"},
+		{Sorani, 7, "هەینی"},
+		{Kurmanji, 1, "شەمی"},
+		{Kurmanji, 7, "ئەینی"},
+		{Hawrami, 7, "جۆمە"},
+		{Laki, 7, "جومە"},
+		{Kalhuri, 7, "جومە"},
+		{Sorani, 0, ""},
+		{Sorani, 8, ""},
+		{Dialect(99), 1, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			result := WeekdayName(tt.dialect, tt.weekday)
+			if result != tt.expected {
+				t.Errorf("WeekdayName(%d, %d) = %q, expected %q", tt.dialect, tt.weekday, result, tt.expected)
+			}
+		})
+	}
+
+	for i, name := range WeekdayNames {
+		if WeekdayName(Sorani, i) != name && i != 0 {
+			t.Errorf("WeekdayName(Sorani, %d) = %q, expected WeekdayNames[%d] = %q", i, WeekdayName(Sorani, i), i, name)
+		}
+	}
+}
+
+func TestNameTables(t *testing.T) {
+	for _, dialect := range []Dialect{Laki, Hawrami, Sorani, Kalhuri, Kurmanji} {
+		loc, ok := lookupLocale(dialect)
+		if !ok {
+			t.Fatalf("lookupLocale(%d) not found", dialect)
+		}
+		for _, script := range []Script{ArabicScript, LatinScript} {
+			n, got := loc.namesIn(script)
+			if got != script {
+				t.Errorf("dialect %d has no names in script %d", dialect, script)
+			}
+			if n.months[0] == n.shortMonths[0] {
+				t.Errorf("dialect %d, script %d has no abbreviated month names", dialect, script)
+			}
+		}
+	}
+}
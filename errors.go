@@ -39,3 +39,104 @@ type ErrorInvalidDate struct {
 func (e *ErrorInvalidDate) Error() string {
 	return fmt.Sprintf("invalid date: year=%d, month=%d, day=%d", e.Year, e.Month, e.Day)
 }
+
+// ErrorInvalidDialect represents an error for an unknown dialect.
+type ErrorInvalidDialect struct {
+	Dialect Dialect
+}
+
+func (e *ErrorInvalidDialect) Error() string {
+	return fmt.Sprintf("invalid dialect: %d", int(e.Dialect))
+}
+
+// ErrorInvalidEpoch represents an error for an unknown epoch.
+type ErrorInvalidEpoch struct {
+	Epoch Epoch
+}
+
+func (e *ErrorInvalidEpoch) Error() string {
+	return fmt.Sprintf("invalid epoch: %d", int(e.Epoch))
+}
+
+// ErrorInvalidModel represents an error for an unknown calendar model.
+type ErrorInvalidModel struct {
+	Model CalendarModel
+}
+
+func (e *ErrorInvalidModel) Error() string {
+	return fmt.Sprintf("invalid calendar model: %d", int(e.Model))
+}
+
+// ErrorInvalidTime represents an error for invalid time of day.
+type ErrorInvalidTime struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+}
+
+func (e *ErrorInvalidTime) Error() string {
+	return fmt.Sprintf("invalid time: %02d:%02d:%02d.%09d", e.Hour, e.Minute, e.Second, e.Nanosecond)
+}
+
+// ErrorParse represents an error for a value that does not match its layout.
+type ErrorParse struct {
+	Layout     string
+	Value      string
+	LayoutElem string
+	ValueElem  string
+	Message    string
+}
+
+func (e *ErrorParse) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("parsing %q as %q: cannot parse %q as %q", e.Value, e.Layout, e.ValueElem, e.LayoutElem)
+	}
+	return fmt.Sprintf("parsing %q%s", e.Value, e.Message)
+}
+
+// ErrorInvalidLayout represents an error for a KFormat layout that cannot
+// be used, such as one with a quote that is not closed. Elem is the part of
+// the layout at fault.
+type ErrorInvalidLayout struct {
+	Layout  string
+	Elem    string
+	Message string
+}
+
+func (e *ErrorInvalidLayout) Error() string {
+	return fmt.Sprintf("invalid layout %q: %s in %q", e.Layout, e.Message, e.Elem)
+}
+
+// ErrorInvalidLocale represents an error for a locale that cannot be
+// registered or loaded. Field names the offending entry, such as
+// "Months[3]", or "arabic.months[3]" in a locale file. File is the name
+// of the file the locale was read from, if any.
+type ErrorInvalidLocale struct {
+	File    string
+	Name    string
+	Field   string
+	Message string
+}
+
+func (e *ErrorInvalidLocale) Error() string {
+	msg := e.Message
+	if e.Field != "" {
+		msg = e.Field + " " + msg
+	}
+	if e.File != "" {
+		return fmt.Sprintf("%s: invalid locale %q: %s", e.File, e.Name, msg)
+	}
+	return fmt.Sprintf("invalid locale %q: %s", e.Name, msg)
+}
+
+// ErrorInvalidDirective represents an error for an unknown or incomplete
+// directive in a Strftime format.
+type ErrorInvalidDirective struct {
+	Format    string
+	Directive string
+}
+
+func (e *ErrorInvalidDirective) Error() string {
+	return fmt.Sprintf("invalid directive %q in format %q", e.Directive, e.Format)
+}
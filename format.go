@@ -1,29 +1,62 @@
 package kurdical
 
+import (
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Layouts for common Kurdish date styles, for use with KFormat and KParse.
+// The same layouts serve every dialect: the ezafe token and the comma in
+// them are written as the dialect and script of the date need them when
+// it is formatted. The examples are for Sorani.
+const (
+	KurdishLong     = "Monday، 2ـی Januaryـی 2006"   // سێ‌شەممە، ١ی خاکه‌لێوه‌ی ٢٧٢٣
+	KurdishShort    = "2 Jan 2006"                   // ١ خاک ٢٧٢٣
+	KurdishNumeric  = "2006/01/02"                   // ٢٧٢٣/٠١/٠١
+	KurdishISO      = "2006-01-02"                   // ٢٧٢٣-٠١-٠١
+	KurdishDateTime = "2ـی Januaryـی 2006، 15:04:05" // ١ی خاکه‌لێوه‌ی ٢٧٢٣، ١٥:٠٤:٠٥
+)
+
 const (
-	_              = iota
-	stdLongMonth   = iota + stdNeedDate  // "January"
-	stdMonth                             // "Jan"
-	stdNumMonth                          // "1"
-	stdZeroMonth                         // "01"
-	stdLongWeekDay                       // "Monday"
-	stdWeekDay                           // "Mon"
-	stdDay                               // "2"
-	stdUnderDay                          // "_2"
-	stdZeroDay                           // "02"
-	stdHour        = iota + stdNeedClock // "15"
-	stdHour12                            // "3"
-	stdZeroHour12                        // "03"
-	stdMinute                            // "4"
-	stdZeroMinute                        // "04"
-	stdSecond                            // "5"
-	stdZeroSecond                        // "05"
-	stdLongYear    = iota + stdNeedDate  // "2006"
-	stdYear                              // "06"
-	stdPM          = iota + stdNeedClock // "PM"
-	stdpm                                // "pm"
-	stdFracSecond0                       // ".0", ".00", ... , trailing zeros included
-	stdFracSecond9                       // ".9", ".99", ..., trailing zeros omitted
+	_               = iota
+	stdLongMonth    = iota + stdNeedDate  // "January"
+	stdMonth                              // "Jan"
+	stdNumMonth                           // "1"
+	stdZeroMonth                          // "01"
+	stdLongWeekDay                        // "Monday"
+	stdWeekDay                            // "Mon"
+	stdDay                                // "2"
+	stdUnderDay                           // "_2"
+	stdZeroDay                            // "02"
+	stdHour         = iota + stdNeedClock // "15"
+	stdHour12                             // "3"
+	stdZeroHour12                         // "03"
+	stdMinute                             // "4"
+	stdZeroMinute                         // "04"
+	stdSecond                             // "5"
+	stdZeroSecond                         // "05"
+	stdLongYear     = iota + stdNeedDate  // "2006"
+	stdYear                               // "06"
+	stdPM           = iota + stdNeedClock // "PM"
+	stdpm                                 // "pm"
+	stdFracSecond0                        // ".0", ".00", ... , trailing zeros included
+	stdFracSecond9                        // ".9", ".99", ..., trailing zeros omitted
+	stdOrdinalDay   = iota + stdNeedDate  // "2nd"
+	stdYearPrefix                         // "Year"
+	stdUnderYearDay                       // "__2"
+	stdZeroYearDay                        // "002"
+	stdWeek                               // "W1"
+	stdZeroWeek                           // "W01"
+	stdQuarter                            // "Q1"
+	stdSeason                             // "Spring"
+	stdEra                                // "Era"
+	stdShortEra                           // "Er"
+	stdLeap                               // "Leap"
+	stdQuoted       = iota                // "'text'", "''"
+	stdEzafe                              // "ـی"
+	stdOpenQuote                          // "'text" with no closing quote
 
 	stdNeedDate  = 1 << 8             // need month, day, year
 	stdNeedClock = 2 << 8             // need hour, minute, second
@@ -34,118 +67,493 @@ const (
 // std0x records the std values for "01", "02", ..., "06".
 var std0x = [...]int{stdZeroMonth, stdZeroDay, stdZeroHour12, stdZeroMinute, stdZeroSecond, stdYear}
 
+// arabicComma is written as a Latin comma in Latin script.
+const arabicComma = "،"
+
+// ezafeToken is the layout token that stands for an ezafe: a tatweel
+// followed by yeh, the usual way of writing the suffix on its own, as in
+// "2ـی Januaryـی 2006".
+const ezafeToken = "ـی"
+
+// DigitSystem selects the digits used to write and read numbers.
+type DigitSystem int
+
+const (
+	// DefaultDigits uses the conventional digits of the script:
+	// EasternArabicDigits for ArabicScript and LatinDigits for LatinScript.
+	DefaultDigits DigitSystem = iota
+	// EasternArabicDigits are the Arabic-Indic digits ٠١٢٣٤٥٦٧٨٩ (U+0660–U+0669).
+	EasternArabicDigits
+	// PersianDigits are the extended Arabic-Indic digits ۰۱۲۳۴۵۶۷۸۹ (U+06F0–U+06F9).
+	PersianDigits
+	// LatinDigits are the Western digits 0123456789.
+	LatinDigits
+)
+
+// zero returns the digit zero of the digit system. Unknown digit systems
+// are treated as DefaultDigits.
+func (ds DigitSystem) zero() rune {
+	switch ds {
+	case PersianDigits:
+		return '۰'
+	case LatinDigits:
+		return '0'
+	}
+	return '٠'
+}
+
+// Script selects the alphabet used to write month, weekday and AM/PM names.
+type Script int
+
+const (
+	// ArabicScript is the Kurdish Arabic-based alphabet.
+	ArabicScript Script = iota
+	// LatinScript is the Kurdish Latin (Hawar) alphabet. The Latin names
+	// of Laki, Hawrami and Kalhuri are provisional transliterations of
+	// their Arabic-script names.
+	LatinScript
+)
+
+// FormatOptions controls how KFormatWith writes a date and how KParseWith
+// reads it back. The zero value gives the behaviour of KFormat and KParse.
+type FormatOptions struct {
+	// Digits selects the digits used for numbers.
+	Digits DigitSystem
+	// Script selects the alphabet used for names.
+	Script Script
+	// Orthography selects the spelling of names written in Arabic script.
+	Orthography Orthography
+}
+
 // KFormat gets default Golang layout string and parse put Kurdish calendar information
 // into the final string and return it.
+//
+// Besides the tokens of the Golang layout, "2nd" writes the day as an
+// ordinal number, such as "یەکەم", or as a number in a dialect that has no
+// ordinals, and "Year" writes the word that introduces a year, such as
+// "ساڵی" in "ساڵی ٢٧٢٣". The token "ـی", a tatweel followed by yeh, writes
+// the ezafe that the dialect and script use after the text before it,
+// depending on whether that text ends in a vowel, so that
+// "2ـی Januaryـی 2006" gives "١ی خاکەلێوەی ٢٧٢٣" in Sorani in
+// StandardOrthography and "1ê Nîsanê 2723" in Kurmanji in Latin script.
+//
+// Tokens that describe the place of a date in its year are:
+//
+//	"002", "__2"   day of the year, padded with zeros or spaces to 3 digits
+//	"W1", "W01"    week of the year; weeks begin on Saturday, and week 1 is
+//	               the week of the first day of the year
+//	"Q1"           quarter of the year, 1 to 4
+//	"Spring"       season, such as "بەهار"
+//	"Era", "Er"    era, such as "کوردی" and "ک."
+//	"Leap"         a word, such as "کەبیسە", in a leap year and nothing
+//	               in other years
+//
+// In Latin script, an Arabic comma "،" in the layout is written as a
+// Latin comma.
+//
+// Text between single quotes is written as it is, so that digits and
+// words in it are not taken for tokens: "ساڵی 2006 - بەشی '1'" keeps the
+// final "1". Two single quotes, inside or outside quoted text, stand for
+// one single quote. KFormat returns an error for a quote that is not
+// closed.
 func (k KurdishDate) KFormat(layout string) (string, error) {
+	return k.KFormatWith(layout, FormatOptions{})
+}
+
+// KFormatWith is like KFormat but writes the date as set by opts.
+// Names are taken from the date's dialect; a dialect that has no names in
+// opts.Script is written in its own script, and an unknown dialect is
+// written in Sorani. In Arabic script, the full month name is k.MonthName
+// if it is set.
+func (k KurdishDate) KFormatWith(layout string, opts FormatOptions) (string, error) {
 	const minBufSize = 64
 
+	if err := checkLayout(layout); err != nil {
+		return "", err
+	}
 	bufSize := len(layout)
 	if bufSize < minBufSize { // minimum buffer size
 		bufSize = minBufSize
 	}
 	b := make([]byte, 0, bufSize)
 
-	b, err := k.kAppendFormat(b, layout)
-	return string(b), err
+	return string(k.AppendFormatWith(b, layout, opts)), nil
+}
+
+// AppendFormat is like KFormat but appends the textual representation to
+// b and returns the extended buffer. It does not allocate if b has room
+// for the result. A layout that KFormat rejects is not checked: the text
+// from a quote that is not closed is appended as it is.
+func (k KurdishDate) AppendFormat(b []byte, layout string) []byte {
+	return k.AppendFormatWith(b, layout, FormatOptions{})
+}
+
+// AppendFormatWith is like AppendFormat but writes the date as set by
+// opts, as KFormatWith does.
+func (k KurdishDate) AppendFormatWith(b []byte, layout string, opts FormatOptions) []byte {
+	names, zero := lookupLocaleOrDefault(k.Dialect).resolve(opts)
+	d := k.fields(names)
+	return appendFormat(b, layout, &d, names, zero)
 }
 
-// kAppendFormat is like KFormat but appends the textual
-// representation to b and returns the extended buffer.
-func (k KurdishDate) kAppendFormat(b []byte, layout string) ([]byte, error) {
-	var (
-		year  = -1
-		month int
-		day   int
-		hour  = -1
-		min   int
-		sec   int
-	)
+// fields returns the values of k written by the layout tokens, with the
+// month and era names taken from names. A weekday outside 1 to 7, as in a
+// date built by hand, is found from the date.
+func (k KurdishDate) fields(names *names) calendarDate {
+	weekday := k.Weekday
+	if weekday < 1 || weekday > 7 {
+		if jdn, err := k.julianDay(); err == nil {
+			weekday = kurdishWeekday(time.Weekday((jdn + 1) % 7))
+		}
+	}
+	return calendarDate{
+		year:        k.Year,
+		month:       k.Month,
+		day:         k.Day,
+		yday:        kurdishYearDay(k.Month, k.Day),
+		weekday:     weekday,
+		hour:        k.Hour,
+		min:         k.Minute,
+		sec:         k.Second,
+		nsec:        k.Nanosecond,
+		months:      names.months,
+		shortMonths: names.shortMonths,
+		monthName:   k.MonthName,
+		era:         names.era,
+		shortEra:    names.shortEra,
+		offset:      epochOffsets[k.Epoch],
+		model:       k.Model,
+	}
+}
+
+// calendarDate holds the values written by the layout tokens, taken from
+// a date in the Kurdish or the Gregorian calendar.
+type calendarDate struct {
+	year, month, day int
+	yday             int // day of the year, from 1
+	weekday          int // 1=Saturday, 2=Sunday, ..., 7=Friday
+	hour, min, sec   int
+	nsec             int
+
+	// months and shortMonths are the month names of the calendar.
+	// monthName, if set, is written for the full month name in Arabic
+	// script, and for a month that has no name.
+	months      []string
+	shortMonths []string
+	monthName   string
+	// era and shortEra name the era of the calendar.
+	era      string
+	shortEra string
+
+	// gregorian is set for a date in the Gregorian calendar. Otherwise
+	// offset and model give the Solar Hijri year of the Kurdish date, to
+	// find whether it is a leap year.
+	gregorian bool
+	offset    int
+	model     CalendarModel
+}
+
+// kurdishYearDay returns the day of the year of a Kurdish month and day.
+func kurdishYearDay(month, day int) int {
+	if month <= 6 {
+		return (month-1)*31 + day
+	}
+	return 186 + (month-7)*30 + day
+}
+
+// kurdishMonthDay returns the month and day of a day of a Kurdish year.
+func kurdishMonthDay(yday int) (month, day int) {
+	if yday <= 186 {
+		return (yday-1)/31 + 1, (yday-1)%31 + 1
+	}
+	return (yday-187)/30 + 7, (yday-187)%30 + 1
+}
+
+// week returns the week of the year of d, counting weeks from Saturday,
+// with week 1 being the week of the first day of the year.
+func (d *calendarDate) week() int {
+	first := floorMod(d.weekday-d.yday, 7) // weekday of the first day, 0 for Saturday
+	return (d.yday-1+first)/7 + 1
+}
+
+// season returns the season of d, 0 for spring to 3 for winter, or -1 if
+// its month is out of range. The Kurdish year begins in spring; in the
+// Gregorian calendar, spring is taken to be March to May.
+func (d *calendarDate) season() int {
+	if d.month < 1 || d.month > 12 {
+		return -1
+	}
+	if d.gregorian {
+		return (d.month + 9) % 12 / 3
+	}
+	return (d.month - 1) / 3
+}
+
+// isLeap reports whether the year of d is a leap year.
+func (d *calendarDate) isLeap() bool {
+	if d.gregorian {
+		return d.year%4 == 0 && (d.year%100 != 0 || d.year%400 == 0)
+	}
+	return isSolarHijriLeap(d.year-d.offset, d.model)
+}
+
+// appendFormat appends d, formatted by layout with the given names and
+// digits, to b and returns the extended buffer.
+func appendFormat(b []byte, layout string, d *calendarDate, names *names, zero rune) []byte {
+	mark := 0 // start of the text an ezafe follows
 	// Each iteration generates one std value.
 	for layout != "" {
 		prefix, std, suffix := nextStdChunk(layout)
 		if prefix != "" {
-			b = append(b, prefix...)
+			mark = len(b)
+			b = appendLiteral(b, prefix, names)
 		}
 		if std == 0 {
 			break
 		}
+		stdstr := layout[len(prefix) : len(layout)-len(suffix)]
 		layout = suffix
 
-		// Compute year, month, day if needed.
-		if year < 0 && std&stdNeedDate != 0 {
-			year, month, day = k.Year, k.Month, k.Day
+		if std == stdEzafe {
+			b = appendEzafe(b, names.endsInVowel(b[mark:]), names)
+			continue
 		}
+		mark = len(b)
+		b = appendStd(b, std, stdstr, d, names, zero)
+	}
+	return b
+}
 
-		// Compute hour, minute, second if needed.
-		if hour < 0 && std&stdNeedClock != 0 {
-			// KurdishDate doesn't have time, so use 0
-			hour, min, sec = 0, 0, 0
+// appendStd appends the value of d written by the std token stdstr, with
+// the given names and digits, to b and returns the extended buffer.
+func appendStd(b []byte, std int, stdstr string, d *calendarDate, names *names, zero rune) []byte {
+	year, month, day := d.year, d.month, d.day
+	hour, min, sec := d.hour, d.min, d.sec
+	switch std & stdMask {
+	case stdYear:
+		y := year
+		if y < 0 {
+			y = -y
 		}
-
-		switch std & stdMask {
-		case stdYear:
-			y := year
-			if y < 0 {
-				y = -y
-			}
-			b = appendInt(b, y%100, 2)
-		case stdLongYear:
-			b = appendInt(b, year, 4)
-		case stdMonth, stdLongMonth:
-			b = append(b, []byte(k.MonthName)...)
-		case stdNumMonth:
-			b = appendInt(b, month, 0)
-		case stdZeroMonth:
-			b = appendInt(b, month, 2)
-		case stdWeekDay, stdLongWeekDay:
-			if k.Weekday >= 1 && k.Weekday <= 7 {
-				b = append(b, []byte(WeekdayNames[k.Weekday])...)
-			}
-		case stdDay:
-			b = appendInt(b, day, 0)
-		case stdUnderDay:
-			if day < 10 {
+		b = appendInt(b, y%100, 2, zero)
+	case stdLongYear:
+		b = appendInt(b, year, 4, zero)
+	case stdMonth, stdLongMonth:
+		if std == stdLongMonth && d.monthName != "" && names.script == ArabicScript {
+			b = append(b, spell(d.monthName, names.orthography, names.isVowelWord)...)
+			break
+		}
+		tab := d.months
+		if std == stdMonth {
+			tab = d.shortMonths
+		}
+		if month >= 1 && month <= len(tab) {
+			b = append(b, tab[month-1]...)
+		} else {
+			b = append(b, spell(d.monthName, names.orthography, names.isVowelWord)...)
+		}
+	case stdNumMonth:
+		b = appendInt(b, month, 0, zero)
+	case stdZeroMonth:
+		b = appendInt(b, month, 2, zero)
+	case stdWeekDay, stdLongWeekDay:
+		tab := names.weekdays
+		if std == stdWeekDay {
+			tab = names.shortWeekdays
+		}
+		if d.weekday >= 1 && d.weekday < len(tab) {
+			b = append(b, tab[d.weekday]...)
+		}
+	case stdDay:
+		b = appendInt(b, day, 0, zero)
+	case stdUnderDay:
+		if day < 10 {
+			b = append(b, ' ')
+		}
+		b = appendInt(b, day, 0, zero)
+	case stdZeroDay:
+		b = appendInt(b, day, 2, zero)
+	case stdHour:
+		b = appendInt(b, hour, 2, zero)
+	case stdHour12:
+		// Noon is 12PM, midnight is 12AM.
+		hr := hour % 12
+		if hr == 0 {
+			hr = 12
+		}
+		b = appendInt(b, hr, 0, zero)
+	case stdZeroHour12:
+		// Noon is 12PM, midnight is 12AM.
+		hr := hour % 12
+		if hr == 0 {
+			hr = 12
+		}
+		b = appendInt(b, hr, 2, zero)
+	case stdMinute:
+		b = appendInt(b, min, 0, zero)
+	case stdZeroMinute:
+		b = appendInt(b, min, 2, zero)
+	case stdSecond:
+		b = appendInt(b, sec, 0, zero)
+	case stdZeroSecond:
+		b = appendInt(b, sec, 2, zero)
+	case stdPM, stdpm:
+		if hour >= 12 {
+			b = append(b, names.pm...)
+		} else {
+			b = append(b, names.am...)
+		}
+	case stdFracSecond0, stdFracSecond9:
+		b = formatNano(b, uint(d.nsec), std>>stdArgShift, std&stdMask == stdFracSecond9, zero)
+	case stdOrdinalDay:
+		if day >= 1 && day <= len(names.ordinals) {
+			b = append(b, names.ordinals[day-1]...)
+		} else {
+			b = appendInt(b, day, 0, zero)
+		}
+	case stdYearPrefix:
+		b = append(b, names.yearPrefix...)
+	case stdUnderYearDay:
+		if d.yday < 100 {
+			b = append(b, ' ')
+			if d.yday < 10 {
 				b = append(b, ' ')
 			}
-			b = appendInt(b, day, 0)
-		case stdZeroDay:
-			b = appendInt(b, day, 2)
-		case stdHour:
-			b = appendInt(b, hour, 2)
-		case stdHour12:
-			// Noon is 12PM, midnight is 12AM.
-			hr := hour % 12
-			if hr == 0 {
-				hr = 12
-			}
-			b = appendInt(b, hr, 0)
-		case stdZeroHour12:
-			// Noon is 12PM, midnight is 12AM.
-			hr := hour % 12
-			if hr == 0 {
-				hr = 12
-			}
-			b = appendInt(b, hr, 2)
-		case stdMinute:
-			b = appendInt(b, min, 0)
-		case stdZeroMinute:
-			b = appendInt(b, min, 2)
-		case stdSecond:
-			b = appendInt(b, sec, 0)
-		case stdZeroSecond:
-			b = appendInt(b, sec, 2)
-		case stdPM, stdpm:
-			if hour >= 12 {
-				b = append(b, "دوای نیوەڕۆ"...)
+		}
+		b = appendInt(b, d.yday, 0, zero)
+	case stdZeroYearDay:
+		b = appendInt(b, d.yday, 3, zero)
+	case stdWeek:
+		b = appendInt(b, d.week(), 0, zero)
+	case stdZeroWeek:
+		b = appendInt(b, d.week(), 2, zero)
+	case stdQuarter:
+		b = appendInt(b, (month-1)/3+1, 0, zero)
+	case stdSeason:
+		if s := d.season(); s >= 0 && s < len(names.seasons) {
+			b = append(b, names.seasons[s]...)
+		}
+	case stdEra:
+		b = append(b, d.era...)
+	case stdShortEra:
+		b = append(b, d.shortEra...)
+	case stdLeap:
+		if d.isLeap() {
+			b = append(b, names.leapYear...)
+		}
+	case stdQuoted:
+		b = appendQuoted(b, stdstr)
+	case stdOpenQuote:
+		b = append(b, stdstr...)
+	}
+	return b
+}
+
+// appendLiteral appends literal layout text to b, with each Arabic comma
+// written as a Latin comma if names is in Latin script.
+func appendLiteral(b []byte, text string, names *names) []byte {
+	if names.script != LatinScript {
+		return append(b, text...)
+	}
+	for {
+		i := strings.Index(text, arabicComma)
+		if i < 0 {
+			return append(b, text...)
+		}
+		b = append(b, text[:i]...)
+		b = append(b, ',')
+		text = text[i+len(arabicComma):]
+	}
+}
+
+// appendEzafe appends the ezafe that joins the text at the end of b to
+// the word that follows; vowel reports whether that text ends in a vowel.
+func appendEzafe(b []byte, vowel bool, names *names) []byte {
+	if !vowel {
+		return append(b, names.ezafe...)
+	}
+	if r, _ := utf8.DecodeLastRune(b); r == heh {
+		// The vowel ە spelled as heh must not join the connector.
+		b = utf8.AppendRune(b, zwnj)
+	}
+	return append(b, names.ezafeAfterVowel...)
+}
+
+// endsInVowel reports whether the text s written into a date ends in a
+// vowel. Names are looked up in n; other text, such as a number or a
+// MonthName set on the date, is judged by vowelFinal.
+func (n *names) endsInVowel(s []byte) bool {
+	if v, ok := n.finals[string(s)]; ok {
+		return v
+	}
+	if r, _ := utf8.DecodeLastRune(s); unicode.IsDigit(r) {
+		return false
+	}
+	return vowelFinal(string(s), n.vowels)
+}
+
+// vowelFinal reports whether the last word of name ends in a vowel,
+// judging by its last letter. In Arabic script, a final heh is the vowel
+// ە only in the words in vowels, as found by findVowelWords, and a final
+// و or ی is taken to be the vowel u or î after a consonant and the
+// consonant w or y after a vowel.
+func vowelFinal(name string, vowels map[string]bool) bool {
+	word := name
+	if i := strings.LastIndexByte(name, ' '); i >= 0 {
+		word = name[i+1:]
+	}
+	word = strings.TrimFunc(word, func(r rune) bool { return !unicode.IsLetter(r) })
+	r, size := utf8.DecodeLastRuneInString(word)
+	if unicode.Is(unicode.Latin, r) {
+		return strings.ContainsRune("aeêiîouûAEÊIÎOUÛ", r)
+	}
+	switch r {
+	case '\u0627', ae, '\u06ce', '\u06c6': // ا ە ێ ۆ
+		return true
+	case heh:
+		return len(word) > size && vowels[toStandard(word, nil)]
+	case '\u0648', '\u06cc': // و ی
+		p, _ := utf8.DecodeLastRuneInString(word[:len(word)-size])
+		return isArabicLetter(p) && !strings.ContainsRune("\u0627\u06d5\u06ce\u06c6\u0648\u06cc", p)
+	}
+	return false
+}
+
+// findVowelFinals returns, for every name in n, whether it ends in a
+// vowel, which chooses the form of a following ezafe. An Arabic-script
+// name with the same name in latin takes the answer from the Latin
+// spelling, which tells apart the vowel and the consonant that a final
+// heh, و or ی can stand for. Abbreviations are judged by their own
+// letters, as their Latin forms are cut short.
+func findVowelFinals(n, latin *names) map[string]bool {
+	finals := make(map[string]bool)
+	add := func(tab, latinTab []string) {
+		for i, name := range tab {
+			if latinTab != nil && len(latinTab) == len(tab) {
+				finals[name] = vowelFinal(latinTab[i], nil)
 			} else {
-				b = append(b, "پێش نیوەڕۆ"...)
+				finals[name] = vowelFinal(name, n.vowels)
 			}
-		case stdFracSecond0, stdFracSecond9:
-			// No nanoseconds
-			b = formatNano(b, 0, std>>stdArgShift, std&stdMask == stdFracSecond9)
 		}
 	}
-	return b, nil
+	var l names
+	if latin != nil {
+		l = *latin
+	}
+	// Full names are added last, so that they decide for a name that is
+	// also its own abbreviation.
+	add(n.shortMonths, nil)
+	add(n.shortWeekdays, nil)
+	add(n.shortGregorianMonths, nil)
+	add([]string{n.shortEra, n.shortGregorianEra}, nil)
+	add(n.months, l.months)
+	add(n.weekdays, l.weekdays)
+	add(n.gregorianMonths, l.gregorianMonths)
+	add(n.ordinals, l.ordinals)
+	add(n.seasons, l.seasons)
+	add([]string{n.am, n.pm, n.yearPrefix, n.era, n.gregorianEra, n.leapYear},
+		[]string{l.am, l.pm, l.yearPrefix, l.era, l.gregorianEra, l.leapYear})
+	return finals
 }
 
 // nextStdChunk finds the first occurrence of a std string in
@@ -164,7 +572,7 @@ func nextStdChunk(layout string) (prefix string, std int, suffix string) {
 			}
 
 		case 'M': // Monday, Mon
-			if layout[i:i+3] == "Mon" {
+			if len(layout) >= i+3 && layout[i:i+3] == "Mon" {
 				if len(layout) >= i+6 && layout[i:i+6] == "Monday" {
 					return layout[0:i], stdLongWeekDay, layout[i+6:]
 				}
@@ -173,7 +581,10 @@ func nextStdChunk(layout string) (prefix string, std int, suffix string) {
 				}
 			}
 
-		case '0': // 01, 02, 03, 04, 05, 06
+		case '0': // 01, 02, 03, 04, 05, 06, 002
+			if len(layout) >= i+3 && layout[i+1] == '0' && layout[i+2] == '2' {
+				return layout[0:i], stdZeroYearDay, layout[i+3:]
+			}
 			if len(layout) >= i+2 && '1' <= layout[i+1] && layout[i+1] <= '6' {
 				return layout[0:i], std0x[layout[i+1]-'1'], layout[i+2:]
 			}
@@ -184,10 +595,13 @@ func nextStdChunk(layout string) (prefix string, std int, suffix string) {
 			}
 			return layout[0:i], stdNumMonth, layout[i+1:]
 
-		case '2': // 2006, 2
+		case '2': // 2006, 2nd, 2
 			if len(layout) >= i+4 && layout[i:i+4] == "2006" {
 				return layout[0:i], stdLongYear, layout[i+4:]
 			}
+			if len(layout) >= i+3 && layout[i:i+3] == "2nd" {
+				return layout[0:i], stdOrdinalDay, layout[i+3:]
+			}
 			return layout[0:i], stdDay, layout[i+1:]
 
 		case '_': // _2, _2006
@@ -198,6 +612,9 @@ func nextStdChunk(layout string) (prefix string, std int, suffix string) {
 				}
 				return layout[0:i], stdUnderDay, layout[i+2:]
 			}
+			if len(layout) >= i+3 && layout[i+1] == '_' && layout[i+2] == '2' {
+				return layout[0:i], stdUnderYearDay, layout[i+3:]
+			}
 
 		case '3':
 			return layout[0:i], stdHour12, layout[i+1:]
@@ -208,6 +625,65 @@ func nextStdChunk(layout string) (prefix string, std int, suffix string) {
 		case '5':
 			return layout[0:i], stdSecond, layout[i+1:]
 
+		case 'W': // W01, W1
+			if len(layout) >= i+3 && layout[i+1:i+3] == "01" {
+				return layout[0:i], stdZeroWeek, layout[i+3:]
+			}
+			if len(layout) >= i+2 && layout[i+1] == '1' {
+				return layout[0:i], stdWeek, layout[i+2:]
+			}
+
+		case 'Q': // Q1
+			if len(layout) >= i+2 && layout[i+1] == '1' {
+				return layout[0:i], stdQuarter, layout[i+2:]
+			}
+
+		case 'S': // Spring
+			if len(layout) >= i+6 && layout[i:i+6] == "Spring" && !startsWithLowerCase(layout[i+6:]) {
+				return layout[0:i], stdSeason, layout[i+6:]
+			}
+
+		case 'E': // Era, Er
+			if len(layout) >= i+2 && layout[i+1] == 'r' {
+				if len(layout) >= i+3 && layout[i+2] == 'a' && !startsWithLowerCase(layout[i+3:]) {
+					return layout[0:i], stdEra, layout[i+3:]
+				}
+				if !startsWithLowerCase(layout[i+2:]) {
+					return layout[0:i], stdShortEra, layout[i+2:]
+				}
+			}
+
+		case 'L': // Leap
+			if len(layout) >= i+4 && layout[i:i+4] == "Leap" && !startsWithLowerCase(layout[i+4:]) {
+				return layout[0:i], stdLeap, layout[i+4:]
+			}
+
+		case 'Y': // Year
+			if len(layout) >= i+4 && layout[i:i+4] == "Year" && !startsWithLowerCase(layout[i+4:]) {
+				return layout[0:i], stdYearPrefix, layout[i+4:]
+			}
+
+		case 0xd9: // ـی
+			if strings.HasPrefix(layout[i:], ezafeToken) {
+				return layout[0:i], stdEzafe, layout[i+len(ezafeToken):]
+			}
+
+		case '\'': // 'text', ''
+			j := i + 1
+			if j < len(layout) && layout[j] == '\'' {
+				return layout[0:i], stdQuoted, layout[j+1:]
+			}
+			for ; j < len(layout); j++ {
+				if layout[j] == '\'' {
+					if j+1 < len(layout) && layout[j+1] == '\'' {
+						j++
+						continue
+					}
+					return layout[0:i], stdQuoted, layout[j+1:]
+				}
+			}
+			return layout[0:i], stdOpenQuote, ""
+
 		case 'P': // PM
 			if len(layout) >= i+2 && layout[i+1] == 'M' {
 				return layout[0:i], stdPM, layout[i+2:]
@@ -240,6 +716,36 @@ func nextStdChunk(layout string) (prefix string, std int, suffix string) {
 	return layout, 0, ""
 }
 
+// checkLayout returns an error if layout has a quote that is not closed.
+func checkLayout(layout string) error {
+	for rest := layout; rest != ""; {
+		prefix, std, suffix := nextStdChunk(rest)
+		if std == stdOpenQuote {
+			return &ErrorInvalidLayout{Layout: layout, Elem: rest[len(prefix):], Message: "quote is not closed"}
+		}
+		rest = suffix
+	}
+	return nil
+}
+
+// appendQuoted appends the text of a quoted layout run, such as "'Q1'",
+// or of a doubled quote, to b and returns the result.
+func appendQuoted(b []byte, run string) []byte {
+	if run == "''" {
+		return append(b, '\'')
+	}
+	for i := 1; i < len(run); i++ {
+		if run[i] == '\'' {
+			if i+1 == len(run) {
+				break
+			}
+			i++ // a doubled quote
+		}
+		b = append(b, run[i])
+	}
+	return b
+}
+
 // startsWithLowerCase reports whether the string has a lower-case letter at the beginning.
 // Its purpose is to prevent matching strings like "Month" when looking for "Mon".
 func startsWithLowerCase(str string) bool {
@@ -259,44 +765,57 @@ func isDigit(s string, i int) bool {
 	return '0' <= c && c <= '9'
 }
 
-// appendInt appends the decimal form of x to b and returns the result.
+// appendDigit appends the digit d, written with the digits starting at
+// zero, to b in UTF-8 and returns the result.
+func appendDigit(b []byte, d uint, zero rune) []byte {
+	if zero < utf8.RuneSelf {
+		return append(b, byte(zero)+byte(d))
+	}
+	return utf8.AppendRune(b, zero+rune(d))
+}
+
+// appendInt appends the decimal form of x, written with the digits starting at zero, to b
+// and returns the result.
 // If the decimal form (excluding sign) is shorter than width, the result is padded with leading 0's.
 // Duplicates functionality in strconv, but avoids dependency.
-func appendInt(b []byte, x int, width int) []byte {
+func appendInt(b []byte, x int, width int, zero rune) []byte {
 	u := uint(x)
 	if x < 0 {
 		b = append(b, '-')
 		u = uint(-x)
 	}
 
-	// Assemble decimal in reverse order.
-	var buf [20]rune
+	// Assemble the decimal digits in reverse order.
+	var buf [20]byte
 	i := len(buf)
 	for u >= 10 {
 		i--
 		q := u / 10
-		buf[i] = rune('٠' + u - q*10)
+		buf[i] = byte(u - q*10)
 		u = q
 	}
 	i--
-	buf[i] = rune('٠' + u)
+	buf[i] = byte(u)
 
 	// Add 0-padding.
 	for w := len(buf) - i; w < width; w++ {
-		b = append(b, []byte("٠")...)
+		b = appendDigit(b, 0, zero)
 	}
 
-	return append(b, []byte(string(buf[i:]))...)
+	for _, d := range buf[i:] {
+		b = appendDigit(b, uint(d), zero)
+	}
+	return b
 }
 
-// formatNano appends a fractional second, as nanoseconds, to b
-// and returns the result.
-func formatNano(b []byte, nanosec uint, n int, trim bool) []byte {
+// formatNano appends a fractional second, as nanoseconds written with the digits
+// starting at zero, to b and returns the result.
+func formatNano(b []byte, nanosec uint, n int, trim bool, zero rune) []byte {
 	u := nanosec
-	var buf [9]rune
+	var buf [9]byte
 	for start := len(buf); start > 0; {
 		start--
-		buf[start] = rune(u%10 + '٠')
+		buf[start] = byte(u % 10)
 		u /= 10
 	}
 
@@ -304,7 +823,7 @@ func formatNano(b []byte, nanosec uint, n int, trim bool) []byte {
 		n = 9
 	}
 	if trim {
-		for n > 0 && buf[n-1] == '٠' {
+		for n > 0 && buf[n-1] == 0 {
 			n--
 		}
 		if n == 0 {
@@ -312,5 +831,8 @@ func formatNano(b []byte, nanosec uint, n int, trim bool) []byte {
 		}
 	}
 	b = append(b, '.')
-	return append(b, []byte(string(buf[:n]))...)
+	for _, d := range buf[:n] {
+		b = appendDigit(b, uint(d), zero)
+	}
+	return b
 }
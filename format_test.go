@@ -0,0 +1,539 @@
+package kurdical
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+func TestKFormatDigits(t *testing.T) {
+	k := GregorianToKurdish(time.Date(2023, 3, 21, 9, 5, 7, 250000000, time.UTC), Sorani, MedianKingdom)
+	const layout = "2006-01-02 15:04:05.000"
+	tests := []struct {
+		digits   DigitSystem
+		expected string
+	}{
+		{DefaultDigits, "٢٧٢٣-٠١-٠١ ٠٩:٠٥:٠٧.٢٥٠"},
+		{EasternArabicDigits, "٢٧٢٣-٠١-٠١ ٠٩:٠٥:٠٧.٢٥٠"},
+		{PersianDigits, "۲۷۲۳-۰۱-۰۱ ۰۹:۰۵:۰۷.۲۵۰"},
+		{LatinDigits, "2723-01-01 09:05:07.250"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			opts := FormatOptions{Digits: tt.digits}
+			result, err := k.KFormatWith(layout, opts)
+			if err != nil {
+				t.Fatalf("KFormatWith() unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("KFormatWith() = %q, expected %q", result, tt.expected)
+			}
+
+			parsed, err := KParseWith(layout, result, Sorani, MedianKingdom, opts)
+			if err != nil {
+				t.Fatalf("KParseWith() unexpected error: %v", err)
+			}
+			if parsed != k {
+				t.Errorf("KParseWith() = %v, expected %v", parsed, k)
+			}
+		})
+	}
+
+	if _, err := KParseWith(layout, "2723-01-01 09:05:07.250", Sorani, MedianKingdom, FormatOptions{}); err == nil {
+		t.Errorf("KParseWith() expected error for Latin digits with default options, got none")
+	}
+}
+
+func TestKFormatScript(t *testing.T) {
+	// 2723/1/6 is a Sunday.
+	when := time.Date(2023, 3, 26, 15, 4, 0, 0, time.UTC)
+	const layout = "Monday 2 January 2006 3:04 PM"
+	tests := []struct {
+		dialect  Dialect
+		opts     FormatOptions
+		expected string
+	}{
+		{Kurmanji, FormatOptions{Script: LatinScript}, "Yekşem 6 Nîsan 2723 3:04 piştî nîvro"},
+		{Sorani, FormatOptions{Script: LatinScript}, "Yekşemme 6 Xakelêwe 2723 3:04 dway nîweřo"},
+		{Kurmanji, FormatOptions{Script: LatinScript, Digits: EasternArabicDigits}, "Yekşem ٦ Nîsan ٢٧٢٣ ٣:٠٤ piştî nîvro"},
+		{Kurmanji, FormatOptions{Script: ArabicScript}, "یەک‌شەم ٦ نیسان ٢٧٢٣ ٣:٠٤ پشتی نیڤرۆ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			k := GregorianToKurdish(when, tt.dialect, MedianKingdom)
+			result, err := k.KFormatWith(layout, tt.opts)
+			if err != nil {
+				t.Fatalf("KFormatWith() unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("KFormatWith() = %q, expected %q", result, tt.expected)
+			}
+
+			parsed, err := KParseWith(layout, result, tt.dialect, MedianKingdom, tt.opts)
+			if err != nil {
+				t.Fatalf("KParseWith() unexpected error: %v", err)
+			}
+			if parsed != k {
+				t.Errorf("KParseWith() = %v, expected %v", parsed, k)
+			}
+		})
+	}
+}
+
+func TestKFormatMonthName(t *testing.T) {
+	// A MonthName set by the caller is written in Arabic script, as
+	// before scripts were added; Latin script takes the name from the dialect.
+	k := KurdishDate{Year: 2723, Month: 1, Day: 1, Weekday: 4, MonthName: "نەورۆز", Dialect: Sorani, Epoch: MedianKingdom}
+	tests := []struct {
+		layout   string
+		opts     FormatOptions
+		expected string
+	}{
+		{"2 January 2006", FormatOptions{}, "١ نەورۆز ٢٧٢٣"},
+		{"2 January 2006", FormatOptions{Orthography: LegacyOrthography}, "١ نه‌ورۆز ٢٧٢٣"},
+		{"2 Jan 2006", FormatOptions{}, "١ خاک ٢٧٢٣"},
+		{"2 January 2006", FormatOptions{Script: LatinScript}, "1 Xakelêwe 2723"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			result, err := k.KFormatWith(tt.layout, tt.opts)
+			if err != nil {
+				t.Fatalf("KFormatWith() unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("KFormatWith(%q) = %q, expected %q", tt.layout, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestKFormatShortNames(t *testing.T) {
+	// 2723/1/6 is a Sunday.
+	when := time.Date(2023, 3, 26, 0, 0, 0, 0, time.UTC)
+	const layout = "Mon 02 Jan 2006"
+	tests := []struct {
+		dialect  Dialect
+		script   Script
+		expected string
+	}{
+		{Sorani, ArabicScript, "ی ٠٦ خاک ٢٧٢٣"},
+		{Kurmanji, ArabicScript, "ی ٠٦ نیس ٢٧٢٣"},
+		{Kurmanji, LatinScript, "Yek 06 Nîs 2723"},
+		{Laki, LatinScript, "Yek 06 Pen 2723"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			opts := FormatOptions{Script: tt.script}
+			k := GregorianToKurdish(when, tt.dialect, MedianKingdom)
+			result, err := k.KFormatWith(layout, opts)
+			if err != nil {
+				t.Fatalf("KFormatWith() unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("KFormatWith() = %q, expected %q", result, tt.expected)
+			}
+
+			parsed, err := KParseWith(layout, result, tt.dialect, MedianKingdom, opts)
+			if err != nil {
+				t.Fatalf("KParseWith() unexpected error: %v", err)
+			}
+			if parsed != k {
+				t.Errorf("KParseWith() = %v, expected %v", parsed, k)
+			}
+		})
+	}
+}
+
+func TestKFormatGrammar(t *testing.T) {
+	// 2723/1/1 is a Tuesday.
+	newroz := time.Date(2023, 3, 21, 0, 0, 0, 0, time.UTC)
+	feyli, err := RegisterDialect(feyliLocale(uniqueName("Feyli")))
+	if err != nil {
+		t.Fatalf("RegisterDialect() unexpected error: %v", err)
+	}
+	tests := []struct {
+		name     string
+		when     time.Time
+		dialect  Dialect
+		layout   string
+		opts     FormatOptions
+		expected string
+	}{
+		{"Sorani ezafe", newroz, Sorani, "2ـی Januaryـی 2006", FormatOptions{Orthography: StandardOrthography}, "١ی خاکەلێوەی ٢٧٢٣"},
+		{"Sorani legacy ezafe", newroz, Sorani, "2ـی Januaryـی 2006", FormatOptions{Orthography: LegacyOrthography}, "١ی خاکه\u200cلێوه\u200cی ٢٧٢٣"},
+		{"Sorani Latin ezafe", newroz, Sorani, "2ـی Januaryـی 2006", FormatOptions{Script: LatinScript}, "1î Xakelêwey 2723"},
+		{"Kurmanji ezafe", newroz, Kurmanji, "2ـی Januaryـی 2006", FormatOptions{Script: LatinScript}, "1ê Nîsanê 2723"},
+		{"Kurmanji Arabic ezafe", newroz, Kurmanji, "2ـی Januaryـی 2006", FormatOptions{Script: ArabicScript}, "١ێ نیسانێ ٢٧٢٣"},
+		{"Ordinal", newroz, Sorani, "2nd 2006", FormatOptions{}, "یەکەم ٢٧٢٣"},
+		{"Ordinal with ezafe", newroz.AddDate(0, 0, 30), Sorani, "2ndـی Januaryـی 2006", FormatOptions{Orthography: StandardOrthography}, "سی و یەکەمی خاکەلێوەی ٢٧٢٣"},
+		{"Kurmanji ordinal", newroz.AddDate(0, 0, 20), Kurmanji, "Monday, 2nd January 2006", FormatOptions{Script: LatinScript}, "Duşem, bîst û yekem Nîsan 2723"},
+		{"Year prefix", newroz, Sorani, "Year 2006", FormatOptions{}, "ساڵی ٢٧٢٣"},
+		{"Kurmanji year prefix", newroz, Kurmanji, "Year 2006", FormatOptions{Script: LatinScript}, "sala 2723"},
+		{"Custom dialect", newroz.AddDate(0, 0, 1), feyli, "2ndـی Januaryـی Year 2006", FormatOptions{}, "٢ی جەژنانی ساڵی ٢٧٢٣"},
+		{"Ezafe after clock", newroz, Sorani, "15ـی 2006", FormatOptions{}, "٠٠ی ٢٧٢٣"},
+		{"Literal yeh", newroz, Sorani, "یەکەم 2 2006", FormatOptions{}, "یەکەم ١ ٢٧٢٣"},
+		{"Literal yeh after token", newroz, Sorani, "2ی 2006", FormatOptions{}, "١ی ٢٧٢٣"},
+		{"Kurmanji consonant heh", newroz.AddDate(0, 6, 2), Kurmanji, "2ـی Januaryـی 2006", FormatOptions{Script: ArabicScript}, "١ێ جوتمهێ ٢٧٢٣"},
+		{"Kurmanji vowel heh", time.Date(2023, 12, 22, 0, 0, 0, 0, time.UTC), Kurmanji, "Januaryـی 2006", FormatOptions{Script: ArabicScript}, "چله\u200cیێ ٢٧٢٣"},
+		{"Ordinal without data", newroz, Laki, "2ndـی Januaryـی 2006", FormatOptions{}, "١ی په\u200cنجه\u200cی ٢٧٢٣"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := GregorianToKurdish(tt.when, tt.dialect, MedianKingdom)
+			result, err := k.KFormatWith(tt.layout, tt.opts)
+			if err != nil {
+				t.Fatalf("KFormatWith() unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("KFormatWith() = %q, expected %q", result, tt.expected)
+			}
+
+			parsed, err := KParseWith(tt.layout, result, tt.dialect, MedianKingdom, tt.opts)
+			if err != nil {
+				t.Fatalf("KParseWith() unexpected error: %v", err)
+			}
+			if parsed.Day != k.Day {
+				t.Errorf("KParseWith() day = %d, expected %d", parsed.Day, k.Day)
+			}
+		})
+	}
+}
+
+func TestKFormatQuoted(t *testing.T) {
+	// 2723/1/1 is a Tuesday.
+	k := GregorianToKurdish(time.Date(2023, 3, 21, 15, 4, 0, 0, time.UTC), Sorani, MedianKingdom)
+	tests := []struct {
+		name     string
+		layout   string
+		expected string
+	}{
+		{"Digits", "ساڵی 2006 - بەشی '1'", "ساڵی ٢٧٢٣ - بەشی 1"},
+		{"Reference date", "'2006-01-02' 2006-01-02", "2006-01-02 ٢٧٢٣-٠١-٠١"},
+		{"Latin words", "'Report for Monday, January' 2 2006", "Report for Monday, January ١ ٢٧٢٣"},
+		{"Latin letters", "'M' 2 'PM' 2006", "M ١ PM ٢٧٢٣"},
+		{"Doubled quote", "'Kurdistan''s' 2006", "Kurdistan's ٢٧٢٣"},
+		{"Lone doubled quote", "2006''", "٢٧٢٣'"},
+		{"Quoted ezafe", "2'ی' 2006", "١ی ٢٧٢٣"},
+		{"Doubled quotes inside", "2006 'a''''b'", "٢٧٢٣ a''b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := k.KFormat(tt.layout)
+			if err != nil {
+				t.Fatalf("KFormat() unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("KFormat() = %q, expected %q", result, tt.expected)
+			}
+
+			parsed, err := KParse(tt.layout, result, Sorani, MedianKingdom)
+			if err != nil {
+				t.Fatalf("KParse() unexpected error: %v", err)
+			}
+			if parsed.DayKey() != k.DayKey() {
+				t.Errorf("KParse() = %v, expected %v", parsed, k)
+			}
+		})
+	}
+}
+
+func TestKFormatUnclosedQuote(t *testing.T) {
+	k := KurdishDate{Year: 2723, Month: 1, Day: 1, Weekday: 2, Dialect: Sorani, Epoch: MedianKingdom}
+
+	tests := []struct {
+		name   string
+		layout string
+		elem   string
+	}{
+		{"Apostrophe", "Kurdistan's 2006", "'s 2006"},
+		{"Lone quote", "'", "'"},
+		{"Trailing quote", "2006 '", "'"},
+		{"Doubled quote inside", "2006 'a''b", "'a''b"},
+		{"After quoted text", "'Q1' 2006 'Q", "'Q"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var layoutErr *ErrorInvalidLayout
+			if _, err := k.KFormat(tt.layout); !errors.As(err, &layoutErr) {
+				t.Fatalf("KFormat() error = %v, expected ErrorInvalidLayout", err)
+			} else if layoutErr.Elem != tt.elem {
+				t.Errorf("KFormat() error Elem = %q, expected %q", layoutErr.Elem, tt.elem)
+			}
+			if _, err := KFormatGregorian(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), tt.layout, Sorani); !errors.As(err, &layoutErr) {
+				t.Errorf("KFormatGregorian() error = %v, expected ErrorInvalidLayout", err)
+			}
+			if _, err := KParse(tt.layout, "٢٧٢٣", Sorani, MedianKingdom); !errors.As(err, &layoutErr) {
+				t.Errorf("KParse() error = %v, expected ErrorInvalidLayout", err)
+			}
+		})
+	}
+}
+
+func TestKFormatShortLayout(t *testing.T) {
+	// Layouts ending in part of a token must not be read past their end.
+	k := KurdishDate{Year: 2723, Month: 1, Day: 1, Weekday: 4, Dialect: Sorani}
+	for _, layout := range []string{"M", "2 M", "Mo", "J", "Ja", "Y", "Yea", "''"} {
+		t.Run(layout, func(t *testing.T) {
+			if _, err := k.KFormat(layout); err != nil {
+				t.Errorf("KFormat(%q) unexpected error: %v", layout, err)
+			}
+		})
+	}
+}
+
+func TestKFormatYearTokens(t *testing.T) {
+	const layout = "2006-01-02 002 __2 W01 W1 Q1 Spring Era Er Leap"
+	tests := []struct {
+		name     string
+		when     time.Time
+		dialect  Dialect
+		opts     FormatOptions
+		expected string
+	}{
+		// 2723/1/1 is a Tuesday.
+		{"First day", time.Date(2023, 3, 21, 0, 0, 0, 0, time.UTC), Sorani, FormatOptions{}, "٢٧٢٣-٠١-٠١ ٠٠١   ١ ٠١ ١ ١ بەهار کوردی ک. "},
+		// 2723/1/5 is the first Saturday of the year.
+		{"Second week", time.Date(2023, 3, 25, 0, 0, 0, 0, time.UTC), Sorani, FormatOptions{}, "٢٧٢٣-٠١-٠٥ ٠٠٥   ٥ ٠٢ ٢ ١ بەهار کوردی ک. "},
+		{"Autumn", time.Date(2023, 9, 23, 0, 0, 0, 0, time.UTC), Sorani, FormatOptions{Script: LatinScript}, "2723-07-01 187 187 28 28 3 Payîz Kurdî K. "},
+		{"Leap year", time.Date(2025, 3, 20, 0, 0, 0, 0, time.UTC), Sorani, FormatOptions{}, "٢٧٢٤-١٢-٣٠ ٣٦٦ ٣٦٦ ٥٣ ٥٣ ٤ زستان کوردی ک. کەبیسە"},
+		{"Kurmanji", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Kurmanji, FormatOptions{Script: LatinScript}, "2723-10-11 287 287 42 42 4 Zivistan Kurdî K. "},
+		// 2723/4/10 is a Saturday, so it begins a week.
+		{"Hawrami", time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC), Hawrami, FormatOptions{Script: LatinScript}, "2723-04-10 103 103 16 16 2 Hamnan Kurdî K. "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := GregorianToKurdish(tt.when, tt.dialect, MedianKingdom)
+			result, err := k.KFormatWith(layout, tt.opts)
+			if err != nil {
+				t.Fatalf("KFormatWith() unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("KFormatWith() = %q, expected %q", result, tt.expected)
+			}
+
+			parsed, err := KParseWith(layout, result, tt.dialect, MedianKingdom, tt.opts)
+			if err != nil {
+				t.Fatalf("KParseWith() unexpected error: %v", err)
+			}
+			if parsed != k {
+				t.Errorf("KParseWith() = %v, expected %v", parsed, k)
+			}
+		})
+	}
+}
+
+func TestKFormatWithoutWeekday(t *testing.T) {
+	// 2723/1/5 is the first Saturday of the year, in week 2.
+	k := KurdishDate{Year: 2723, Month: 1, Day: 5, Dialect: Sorani, Epoch: MedianKingdom}
+	const layout = "2006-01-02 W1 Monday"
+	result, err := k.KFormat(layout)
+	if err != nil {
+		t.Fatalf("KFormat() unexpected error: %v", err)
+	}
+	if expected := "٢٧٢٣-٠١-٠٥ ٢ شەممە"; result != expected {
+		t.Errorf("KFormat() = %q, expected %q", result, expected)
+	}
+	if result, _ := k.Strftime("%-J"); result != "٢" {
+		t.Errorf("Strftime(%%-J) = %q, expected %q", result, "٢")
+	}
+	parsed, err := KParse(layout, result, Sorani, MedianKingdom)
+	if err != nil {
+		t.Fatalf("KParse() unexpected error: %v", err)
+	}
+	if parsed.DayKey() != k.DayKey() {
+		t.Errorf("KParse() = %v, expected %v", parsed, k)
+	}
+}
+
+func TestKParseYearDay(t *testing.T) {
+	tests := []struct {
+		name   string
+		layout string
+		value  string
+		month  int
+		day    int
+	}{
+		{"First day", "2006 002", "٢٧٢٣ ٠٠١", 1, 1},
+		{"Last day of month 6", "2006 002", "٢٧٢٣ ١٨٦", 6, 31},
+		{"First day of month 7", "2006 __2", "٢٧٢٣ ١٨٧", 7, 1},
+		{"Last day", "2006 002", "٢٧٢٣ ٣٦٥", 12, 29},
+		{"Space padded", "2006 __2", "٢٧٢٣   ٩", 1, 9},
+		{"Matching month and day", "2006-01-02 002", "٢٧٢٣-٠٢-٠١ ٠٣٢", 2, 1},
+		{"Quarter", "2006 Q1", "٢٧٢٣ ٣", 7, 1},
+		{"Season", "2006 Spring", "٢٧٢٣ پاییز", 7, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, err := KParse(tt.layout, tt.value, Sorani, MedianKingdom)
+			if err != nil {
+				t.Fatalf("KParse() unexpected error: %v", err)
+			}
+			if k.Month != tt.month || k.Day != tt.day {
+				t.Errorf("KParse() = %d/%d, expected %d/%d", k.Month, k.Day, tt.month, tt.day)
+			}
+		})
+	}
+}
+
+func TestLayoutsGolden(t *testing.T) {
+	layouts := []struct {
+		name   string
+		layout string
+	}{
+		{"KurdishLong", KurdishLong},
+		{"KurdishShort", KurdishShort},
+		{"KurdishNumeric", KurdishNumeric},
+		{"KurdishISO", KurdishISO},
+		{"KurdishDateTime", KurdishDateTime},
+	}
+	dates := []time.Time{
+		time.Date(2023, 3, 21, 15, 4, 5, 0, time.UTC),   // 2723/1/1, a Tuesday
+		time.Date(2023, 9, 23, 9, 30, 0, 0, time.UTC),   // 2723/7/1, a Saturday
+		time.Date(2025, 3, 20, 23, 59, 59, 0, time.UTC), // 2724/12/30, in a leap year
+	}
+	scripts := []struct {
+		name   string
+		script Script
+	}{
+		{"arabic", ArabicScript},
+		{"latin", LatinScript},
+	}
+
+	for _, dialect := range []Dialect{Laki, Hawrami, Sorani, Kalhuri, Kurmanji} {
+		t.Run(dialect.String(), func(t *testing.T) {
+			var got strings.Builder
+			for _, s := range scripts {
+				opts := FormatOptions{Script: s.script}
+				for _, l := range layouts {
+					for _, when := range dates {
+						k := GregorianToKurdish(when, dialect, MedianKingdom)
+						result, err := k.KFormatWith(l.layout, opts)
+						if err != nil {
+							t.Fatalf("KFormatWith(%s) unexpected error: %v", l.name, err)
+						}
+						fmt.Fprintf(&got, "%s\t%s\t%s\t%s\n", l.name, s.name, when.Format("2006-01-02"), result)
+
+						parsed, err := KParseWith(l.layout, result, dialect, MedianKingdom, opts)
+						if err != nil {
+							t.Fatalf("KParseWith(%s, %q) unexpected error: %v", l.name, result, err)
+						}
+						if parsed.DayKey() != k.DayKey() {
+							t.Errorf("KParseWith(%s, %q) = %v, expected %v", l.name, result, parsed, k)
+						}
+						if strings.Contains(l.layout, "15") && (parsed.Hour != k.Hour || parsed.Minute != k.Minute || parsed.Second != k.Second) {
+							t.Errorf("KParseWith(%s, %q) time = %02d:%02d:%02d, expected %02d:%02d:%02d",
+								l.name, result, parsed.Hour, parsed.Minute, parsed.Second, k.Hour, k.Minute, k.Second)
+						}
+					}
+				}
+			}
+
+			golden := filepath.Join("testdata", "layouts", strings.ToLower(dialect.String())+".golden")
+			if *update {
+				if err := os.WriteFile(golden, []byte(got.String()), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("reading golden file: %v (run go test -update to create it)", err)
+			}
+			if got.String() != string(want) {
+				t.Errorf("output differs from %s:\ngot:\n%s\nwant:\n%s", golden, got.String(), want)
+			}
+		})
+	}
+}
+
+func TestAppendFormat(t *testing.T) {
+	k := GregorianToKurdish(time.Date(2023, 3, 21, 15, 4, 5, 120000000, time.UTC), Sorani, MedianKingdom)
+	tests := []struct {
+		layout string
+		opts   FormatOptions
+	}{
+		{KurdishLong, FormatOptions{}},
+		{KurdishDateTime, FormatOptions{Digits: PersianDigits}},
+		{KurdishISO, FormatOptions{Digits: LatinDigits}},
+		{"2006-01-02 15:04:05.000000000", FormatOptions{}},
+		{"15:04:05.999", FormatOptions{Digits: PersianDigits}},
+		{"-2006", FormatOptions{Digits: LatinDigits}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.layout, func(t *testing.T) {
+			expected, err := k.KFormatWith(tt.layout, tt.opts)
+			if err != nil {
+				t.Fatalf("KFormatWith(%q) unexpected error: %v", tt.layout, err)
+			}
+			got := k.AppendFormatWith([]byte("log: "), tt.layout, tt.opts)
+			if string(got) != "log: "+expected {
+				t.Errorf("AppendFormatWith(%q) = %q, expected %q", tt.layout, got, "log: "+expected)
+			}
+		})
+	}
+
+	if got, expected := string(k.AppendFormat(nil, KurdishISO)), "٢٧٢٣-٠١-٠١"; got != expected {
+		t.Errorf("AppendFormat(KurdishISO) = %q, expected %q", got, expected)
+	}
+}
+
+func TestAppendFormatAllocs(t *testing.T) {
+	k := GregorianToKurdish(time.Date(2023, 3, 21, 15, 4, 5, 0, time.UTC), Sorani, MedianKingdom)
+	b := make([]byte, 0, 128)
+	for _, layout := range []string{KurdishLong, KurdishShort, KurdishNumeric, KurdishISO, KurdishDateTime, "2006-01-02 15:04:05.000"} {
+		allocs := testing.AllocsPerRun(100, func() {
+			b = k.AppendFormat(b[:0], layout)
+		})
+		if allocs != 0 {
+			t.Errorf("AppendFormat(%q) allocated %v times, expected 0", layout, allocs)
+		}
+	}
+}
+
+func BenchmarkAppendFormat(b *testing.B) {
+	k := GregorianToKurdish(time.Date(2023, 3, 21, 15, 4, 5, 0, time.UTC), Sorani, MedianKingdom)
+	layouts := []struct {
+		name   string
+		layout string
+	}{
+		{"KurdishLong", KurdishLong},
+		{"KurdishISO", KurdishISO},
+		{"KurdishDateTime", KurdishDateTime},
+		{"Nanoseconds", "2006-01-02 15:04:05.000000000"},
+	}
+	for _, l := range layouts {
+		b.Run(l.name, func(b *testing.B) {
+			buf := make([]byte, 0, 128)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				buf = k.AppendFormat(buf[:0], l.layout)
+			}
+		})
+	}
+}
+
+func BenchmarkKFormat(b *testing.B) {
+	k := GregorianToKurdish(time.Date(2023, 3, 21, 15, 4, 5, 0, time.UTC), Sorani, MedianKingdom)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := k.KFormat(KurdishDateTime); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package kurdical
+
+import "time"
+
+// KFormatGregorian formats the Gregorian date and time of t, in t's
+// location, with a KFormat layout. Month names are the Kurdish names of
+// the Gregorian months in the dialect, such as "ئازار" for March in
+// Sorani or "Adar" in Kurmanji, and numbers are written with Kurdish
+// digits.
+func KFormatGregorian(t time.Time, layout string, dialect Dialect) (string, error) {
+	return KFormatGregorianWith(t, layout, dialect, FormatOptions{})
+}
+
+// KFormatGregorianWith is like KFormatGregorian but writes the date as set
+// by opts, as KFormatWith does.
+func KFormatGregorianWith(t time.Time, layout string, dialect Dialect, opts FormatOptions) (string, error) {
+	loc, ok := lookupLocale(dialect)
+	if !ok {
+		return "", &ErrorInvalidDialect{Dialect: dialect}
+	}
+	if err := checkLayout(layout); err != nil {
+		return "", err
+	}
+	names, zero := loc.resolve(opts)
+
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	d := calendarDate{
+		year:        year,
+		month:       int(month),
+		day:         day,
+		yday:        t.YearDay(),
+		weekday:     kurdishWeekday(t.Weekday()),
+		hour:        hour,
+		min:         min,
+		sec:         sec,
+		nsec:        t.Nanosecond(),
+		months:      names.gregorianMonths,
+		shortMonths: names.shortGregorianMonths,
+		era:         names.gregorianEra,
+		shortEra:    names.shortGregorianEra,
+		gregorian:   true,
+	}
+
+	b := make([]byte, 0, 64)
+	return string(appendFormat(b, layout, &d, names, zero)), nil
+}
+
+// kurdishWeekday converts a time.Weekday to the numbering of
+// KurdishDate.Weekday, which starts with 1 for Saturday.
+func kurdishWeekday(wd time.Weekday) int {
+	return (int(wd)+1)%7 + 1
+}
@@ -0,0 +1,107 @@
+package kurdical
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestKFormatGregorian(t *testing.T) {
+	// 2024-03-05 is a Tuesday.
+	when := time.Date(2024, 3, 5, 14, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		dialect  Dialect
+		layout   string
+		opts     FormatOptions
+		expected string
+	}{
+		{"Sorani", Sorani, "Monday 2 January 2006", FormatOptions{}, "سێ‌شەممە ٥ ئازار ٢٠٢٤"},
+		{"Sorani Latin", Sorani, "2 January 2006", FormatOptions{Script: LatinScript}, "5 Azar 2024"},
+		{"Kurmanji", Kurmanji, "Monday, 2 January 2006", FormatOptions{Script: LatinScript}, "Sêşem, 5 Adar 2024"},
+		{"Kurmanji short", Kurmanji, "02 Jan 06", FormatOptions{Script: LatinScript}, "05 Ada 24"},
+		{"Kurmanji Arabic short", Kurmanji, "02 Jan 06", FormatOptions{}, "٠٥ ئاد ٢٤"},
+		{"Kalhuri", Kalhuri, "2 January 2006", FormatOptions{}, "٥ مارس ٢٠٢٤"},
+		{"Clock", Sorani, "2006-01-02 3:04 PM", FormatOptions{}, "٢٠٢٤-٠٣-٠٥ ٢:٣٠ دوای نیوەڕۆ"},
+		{"Persian digits", Sorani, "2006/01/02", FormatOptions{Digits: PersianDigits}, "۲۰۲۴/۰۳/۰۵"},
+		{"Legacy", Sorani, "January", FormatOptions{Orthography: LegacyOrthography}, "ئازار"},
+		{"Year tokens", Sorani, "002 W1 Q1 Spring Era Er Leap", FormatOptions{}, "٠٦٥ ١٠ ١ بەهار زایینی ز. کەبیسە"},
+		{"Kurmanji era", Kurmanji, "2006 Era", FormatOptions{Script: LatinScript}, "2024 Zayînî"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := KFormatGregorianWith(when, tt.layout, tt.dialect, tt.opts)
+			if err != nil {
+				t.Fatalf("KFormatGregorianWith() unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("KFormatGregorianWith() = %q, expected %q", result, tt.expected)
+			}
+		})
+	}
+
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	if result, _ := KFormatGregorian(jan, "January", Sorani); result != "کانوونی دووەم" {
+		t.Errorf("KFormatGregorian() = %q, expected %q", result, "کانوونی دووەم")
+	}
+	if result, _ := KFormatGregorianWith(jan, "January", Sorani, FormatOptions{Orthography: LegacyOrthography}); result != "کانوونی دووه‌م" {
+		t.Errorf("KFormatGregorianWith() = %q, expected %q", result, "کانوونی دووه‌م")
+	}
+
+	var dialectErr *ErrorInvalidDialect
+	if _, err := KFormatGregorian(when, "January", Dialect(99)); !errors.As(err, &dialectErr) {
+		t.Errorf("KFormatGregorian() error = %v, expected ErrorInvalidDialect", err)
+	}
+}
+
+func TestKFormatGregorianLocation(t *testing.T) {
+	erbil := time.FixedZone("AST", 3*60*60)
+	when := time.Date(2023, 12, 31, 22, 0, 0, 0, time.UTC).In(erbil)
+	result, err := KFormatGregorian(when, "2 January 2006 15:04", Kurmanji)
+	if err != nil {
+		t.Fatalf("KFormatGregorian() unexpected error: %v", err)
+	}
+	if expected := "١ چله ٢٠٢٤ ٠١:٠٠"; result != expected {
+		t.Errorf("KFormatGregorian() = %q, expected %q", result, expected)
+	}
+}
+
+func TestKurmanjiGregorianMonths(t *testing.T) {
+	// The Kurmanji Gregorian months are the months of the Kurdish calendar,
+	// and are spelled the same in both tables.
+	loc, _ := lookupLocale(Kurmanji)
+	for _, n := range loc.scripts {
+		for i, name := range n.gregorianMonths {
+			if expected := n.months[(i+9)%12]; name != expected {
+				t.Errorf("Gregorian month %d = %q, expected %q", i+1, name, expected)
+			}
+		}
+	}
+}
+
+func TestGregorianMonthsFallback(t *testing.T) {
+	d, err := RegisterDialect(zazakiLocale(uniqueName("Gregorian")))
+	if err != nil {
+		t.Fatalf("RegisterDialect() unexpected error: %v", err)
+	}
+	result, err := KFormatGregorian(time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC), "January", d)
+	if err != nil {
+		t.Fatalf("KFormatGregorian() unexpected error: %v", err)
+	}
+	if expected := "Tişrînî Yekem"; result != expected {
+		t.Errorf("KFormatGregorian() = %q, expected %q", result, expected)
+	}
+}
+
+func TestKurdishWeekday(t *testing.T) {
+	expected := map[time.Weekday]int{
+		time.Saturday: 1, time.Sunday: 2, time.Monday: 3, time.Tuesday: 4,
+		time.Wednesday: 5, time.Thursday: 6, time.Friday: 7,
+	}
+	for wd, want := range expected {
+		if got := kurdishWeekday(wd); got != want {
+			t.Errorf("kurdishWeekday(%v) = %d, expected %d", wd, got, want)
+		}
+	}
+}
@@ -31,14 +31,25 @@ const (
 )
 
 // KurdishDate represents a date in the Kurdish calendar.
+//
+// Hour, Minute, Second and Nanosecond hold the time of day in Location.
+// A nil Location means UTC, as it does for time.Time.
+// Model is the calendar model used to convert the date; the zero value
+// is the default Arithmetic model.
 type KurdishDate struct {
-	Year      int
-	Month     int
-	Day       int
-	Weekday   int // 1=Saturday, 2=Sunday, ..., 7=Friday
-	MonthName string
-	Dialect   Dialect
-	Epoch     Epoch
+	Year       int
+	Month      int
+	Day        int
+	Weekday    int // 1=Saturday, 2=Sunday, ..., 7=Friday
+	MonthName  string
+	Dialect    Dialect
+	Epoch      Epoch
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+	Location   *time.Location
+	Model      CalendarModel
 }
 
 // epochOffsets maps epochs to their year offsets from Solar Hijri.
@@ -48,36 +59,79 @@ var epochOffsets = map[Epoch]int{
 }
 
 // GregorianToKurdish converts a Gregorian time.Time to a KurdishDate.
+// The time of day and location of t are kept in the result.
+// It returns the zero KurdishDate if t cannot be converted;
+// use GregorianToKurdishE to get the reason.
 func GregorianToKurdish(t time.Time, dialect Dialect, epoch Epoch) KurdishDate {
+	k, _ := GregorianToKurdishE(t, dialect, epoch)
+	return k
+}
+
+// GregorianToKurdishE is like GregorianToKurdish but returns an error
+// for an unknown dialect or epoch, or a year outside the supported range.
+func GregorianToKurdishE(t time.Time, dialect Dialect, epoch Epoch) (KurdishDate, error) {
+	return GregorianToKurdishModel(t, dialect, epoch, Arithmetic)
+}
+
+// GregorianToKurdishModel is like GregorianToKurdishE but converts with
+// the given calendar model.
+func GregorianToKurdishModel(t time.Time, dialect Dialect, epoch Epoch, model CalendarModel) (KurdishDate, error) {
 	year, month, day := t.Date()
-	return GregorianToKurdishDate(year, int(month), day, dialect, epoch)
+	k, err := GregorianToKurdishDateModel(year, int(month), day, dialect, epoch, model)
+	if err != nil {
+		return KurdishDate{}, err
+	}
+	k.Hour, k.Minute, k.Second = t.Clock()
+	k.Nanosecond = t.Nanosecond()
+	if loc := t.Location(); loc != time.UTC {
+		k.Location = loc
+	}
+	return k, nil
 }
 
 // GregorianToKurdishDate converts Gregorian year, month, day to KurdishDate.
+// It returns the zero KurdishDate if the date cannot be converted;
+// use GregorianToKurdishDateE to get the reason.
 func GregorianToKurdishDate(year, month, day int, dialect Dialect, epoch Epoch) KurdishDate {
-	sYear, sMonth, sDay := gregorianToSolarHijri(year, month, day)
-	kYear := sYear + epochOffsets[epoch]
-	monthName := monthNames[dialect][sMonth-1]
+	k, _ := GregorianToKurdishDateE(year, month, day, dialect, epoch)
+	return k
+}
+
+// GregorianToKurdishDateE is like GregorianToKurdishDate but returns an error
+// for an unknown dialect or epoch, a Gregorian date that does not exist
+// (such as February 30), or a year outside the supported range.
+func GregorianToKurdishDateE(year, month, day int, dialect Dialect, epoch Epoch) (KurdishDate, error) {
+	return GregorianToKurdishDateModel(year, month, day, dialect, epoch, Arithmetic)
+}
+
+// GregorianToKurdishDateModel is like GregorianToKurdishDateE but converts
+// with the given calendar model.
+func GregorianToKurdishDateModel(year, month, day int, dialect Dialect, epoch Epoch, model CalendarModel) (KurdishDate, error) {
+	loc, ok := lookupLocale(dialect)
+	if !ok {
+		return KurdishDate{}, &ErrorInvalidDialect{Dialect: dialect}
+	}
+	offset, ok := epochOffsets[epoch]
+	if !ok {
+		return KurdishDate{}, &ErrorInvalidEpoch{Epoch: epoch}
+	}
+	if t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC); month < 1 || month > 12 || t.Day() != day {
+		return KurdishDate{}, &ErrorInvalidDate{Year: year, Month: month, Day: day}
+	}
+	sYear, sMonth, sDay, err := gregorianToSolarHijri(year, month, day, model)
+	if err != nil {
+		if _, ok := err.(*ErrorInvalidModel); ok {
+			return KurdishDate{}, err
+		}
+		return KurdishDate{}, &ErrorInvalidYear{Year: year - 621 + offset}
+	}
+	kYear := sYear + offset
+	names, _ := loc.namesIn(ArabicScript)
+	monthName := names.months[sMonth-1]
 
 	// Calculate weekday from Gregorian date
 	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
-	weekday := int(t.Weekday())
-	switch weekday {
-	case 0: // Sunday
-		weekday = 2
-	case 1: // Monday
-		weekday = 3
-	case 2: // Tuesday
-		weekday = 4
-	case 3: // Wednesday
-		weekday = 5
-	case 4: // Thursday
-		weekday = 6
-	case 5: // Friday
-		weekday = 7
-	case 6: // Saturday
-		weekday = 1
-	}
+	weekday := kurdishWeekday(t.Weekday())
 
 	return KurdishDate{
 		Year:      kYear,
@@ -87,31 +141,66 @@ func GregorianToKurdishDate(year, month, day int, dialect Dialect, epoch Epoch)
 		MonthName: monthName,
 		Dialect:   dialect,
 		Epoch:     epoch,
-	}
+		Model:     model,
+	}, nil
 }
 
-// KurdishToGregorian converts a KurdishDate to a Gregorian time.Time.
+// KurdishToGregorian converts a KurdishDate to a Gregorian time.Time
+// at the date's time of day in its location.
 func KurdishToGregorian(k KurdishDate) (time.Time, error) {
-	year, month, day, err := KurdishToGregorianDate(k.Year, k.Month, k.Day, k.Epoch)
+	year, month, day, err := KurdishToGregorianDateModel(k.Year, k.Month, k.Day, k.Epoch, k.Model)
 	if err != nil {
 		return time.Time{}, err
 	}
-	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC), nil
+	if k.Hour < 0 || k.Hour > 23 || k.Minute < 0 || k.Minute > 59 ||
+		k.Second < 0 || k.Second > 59 || k.Nanosecond < 0 || k.Nanosecond > 999999999 {
+		return time.Time{}, &ErrorInvalidTime{Hour: k.Hour, Minute: k.Minute, Second: k.Second, Nanosecond: k.Nanosecond}
+	}
+	loc := k.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	return time.Date(year, time.Month(month), day, k.Hour, k.Minute, k.Second, k.Nanosecond, loc), nil
 }
 
 // KurdishToGregorianDate converts Kurdish year, month, day to Gregorian year, month, day.
 func KurdishToGregorianDate(kYear, kMonth, kDay int, epoch Epoch) (int, int, int, error) {
+	return KurdishToGregorianDateModel(kYear, kMonth, kDay, epoch, Arithmetic)
+}
+
+// KurdishToGregorianDateModel is like KurdishToGregorianDate but converts
+// with the given calendar model.
+func KurdishToGregorianDateModel(kYear, kMonth, kDay int, epoch Epoch, model CalendarModel) (int, int, int, error) {
+	offset, ok := epochOffsets[epoch]
+	if !ok {
+		return 0, 0, 0, &ErrorInvalidEpoch{Epoch: epoch}
+	}
 	if kMonth < 1 || kMonth > 12 {
 		return 0, 0, 0, &ErrorInvalidMonth{Month: kMonth}
 	}
-	monthDays := []int{31, 31, 31, 31, 31, 31, 30, 30, 30, 30, 30, 29}
-	sYear := kYear - epochOffsets[epoch]
-	if isSolarHijriLeap(sYear) {
-		monthDays[11] = 30
-	}
-	if kDay < 1 || kDay > monthDays[kMonth-1] {
+	sYear := kYear - offset
+	if kDay < 1 || kDay > daysInMonth(sYear, kMonth, model) {
 		return 0, 0, 0, &ErrorInvalidDay{Day: kDay}
 	}
-	gYear, gMonth, gDay := solarHijriToGregorian(sYear, kMonth, kDay)
+	gYear, gMonth, gDay, err := solarHijriToGregorian(sYear, kMonth, kDay, model)
+	if err != nil {
+		if _, ok := err.(*ErrorInvalidModel); ok {
+			return 0, 0, 0, err
+		}
+		return 0, 0, 0, &ErrorInvalidYear{Year: kYear}
+	}
 	return gYear, gMonth, gDay, nil
 }
+
+// daysInMonth returns the number of days in the given month of a Solar Hijri year.
+func daysInMonth(sYear, month int, model CalendarModel) int {
+	switch {
+	case month <= 6:
+		return 31
+	case month <= 11:
+		return 30
+	case isSolarHijriLeap(sYear, model):
+		return 30
+	}
+	return 29
+}
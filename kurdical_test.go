@@ -1,6 +1,7 @@
 package kurdical
 
 import (
+	"errors"
 	"testing"
 	"time"
 )
@@ -88,7 +89,7 @@ func TestGregorianToKurdish(t *testing.T) {
 				Month:     1,
 				Day:       1,
 				Weekday:   4,
-				MonthName: "جه\u200cژنان (جه\u200cشنان)",
+				MonthName: "جه\u200cژنان",
 				Dialect:   Kalhuri,
 				Epoch:     MedianKingdom,
 			},
@@ -189,7 +190,7 @@ func TestKurdishToGregorian(t *testing.T) {
 		{
 			name: "Non-leap year, valid day 29 in month 12",
 			input: KurdishDate{
-				Year:    2724, // 2724 - 1321 = 1403, assuming not leap
+				Year:    2725, // 2725 - 1321 = 1404, not leap
 				Month:   12,
 				Day:     29,
 				Dialect: Sorani,
@@ -202,12 +203,38 @@ func TestKurdishToGregorian(t *testing.T) {
 		{
 			name: "Non-leap year, invalid day 30 in month 12",
 			input: KurdishDate{
-				Year:    2724,
+				Year:    2725,
+				Month:   12,
+				Day:     30,
+				Dialect: Sorani,
+				Epoch:   MedianKingdom,
+			},
+			expected:  time.Time{},
+			hasError:  true,
+			skipEqual: false,
+		},
+		{
+			name: "Leap year, valid day 30 in month 12",
+			input: KurdishDate{
+				Year:    2724, // 2724 - 1321 = 1403, leap
 				Month:   12,
 				Day:     30,
 				Dialect: Sorani,
 				Epoch:   MedianKingdom,
 			},
+			expected:  time.Date(2025, 3, 20, 0, 0, 0, 0, time.UTC),
+			hasError:  false,
+			skipEqual: false,
+		},
+		{
+			name: "Year outside supported range",
+			input: KurdishDate{
+				Year:    9999,
+				Month:   1,
+				Day:     1,
+				Dialect: Sorani,
+				Epoch:   MedianKingdom,
+			},
 			expected:  time.Time{},
 			hasError:  true,
 			skipEqual: false,
@@ -236,7 +263,8 @@ func TestKurdishToGregorian(t *testing.T) {
 func TestMonthNames(t *testing.T) {
 	dialects := []Dialect{Laki, Hawrami, Sorani, Kalhuri, Kurmanji}
 	for _, d := range dialects {
-		names := monthNames[d]
+		loc, _ := LookupLocale(d)
+		names := loc.Months
 		if len(names) != 12 {
 			t.Errorf("Dialect %v has %d month names, expected 12", d, len(names))
 		}
@@ -268,7 +296,15 @@ func FuzzGregorianToKurdish(f *testing.F) {
 		epoch := Epoch(epochInt)
 
 		input := time.Date(year, time.Month(month), day, hour, min, sec, nsec, time.UTC)
-		result := GregorianToKurdish(input, dialect, epoch)
+		result, err := GregorianToKurdishE(input, dialect, epoch)
+		if err != nil {
+			// Years outside the supported range are reported, not converted
+			var yearErr *ErrorInvalidYear
+			if !errors.As(err, &yearErr) {
+				t.Errorf("Unexpected error for input %v: %v", input, err)
+			}
+			return
+		}
 
 		// Check invariants
 		if result.Year < 1 || result.Month < 1 || result.Month > 12 || result.Day < 1 || result.Day > 31 {
@@ -319,3 +355,148 @@ func FuzzKurdishToGregorian(f *testing.F) {
 		}
 	})
 }
+
+func TestTimeOfDayRoundTrip(t *testing.T) {
+	tehran := time.FixedZone("IRST", 3*3600+1800)
+	tests := []struct {
+		name  string
+		input time.Time
+	}{
+		{"UTC", time.Date(2023, 3, 21, 13, 4, 5, 123456789, time.UTC)},
+		{"Fixed zone", time.Date(2024, 3, 19, 23, 59, 59, 999999999, tehran)},
+		{"Midnight", time.Date(2023, 12, 31, 0, 0, 0, 0, tehran)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := GregorianToKurdish(tt.input, Sorani, MedianKingdom)
+			if k.Hour != tt.input.Hour() || k.Minute != tt.input.Minute() ||
+				k.Second != tt.input.Second() || k.Nanosecond != tt.input.Nanosecond() {
+				t.Errorf("GregorianToKurdish() time = %02d:%02d:%02d.%09d, expected %s",
+					k.Hour, k.Minute, k.Second, k.Nanosecond, tt.input.Format("15:04:05.000000000"))
+			}
+			result, err := KurdishToGregorian(k)
+			if err != nil {
+				t.Fatalf("KurdishToGregorian() unexpected error: %v", err)
+			}
+			if !result.Equal(tt.input) || result.Location() != tt.input.Location() {
+				t.Errorf("KurdishToGregorian() = %v, expected %v", result, tt.input)
+			}
+		})
+	}
+}
+
+func TestKurdishToGregorianInvalidTime(t *testing.T) {
+	k := KurdishDate{Year: 2723, Month: 1, Day: 1, Hour: 24, Epoch: MedianKingdom}
+	if _, err := KurdishToGregorian(k); err == nil {
+		t.Errorf("KurdishToGregorian() expected error for hour 24, got none")
+	}
+}
+
+func TestKFormatClock(t *testing.T) {
+	k := GregorianToKurdish(time.Date(2023, 3, 21, 13, 4, 5, 120000000, time.UTC), Sorani, MedianKingdom)
+	tests := []struct {
+		layout   string
+		expected string
+	}{
+		{"15:04:05", "١٣:٠٤:٠٥"},
+		{"3:4:5 PM", "١:٤:٥ دوای نیوەڕۆ"},
+		{"03:04 pm", "٠١:٠٤ دوای نیوەڕۆ"},
+		{"15:04:05.000", "١٣:٠٤:٠٥.١٢٠"},
+		{"15:04:05.999", "١٣:٠٤:٠٥.١٢"},
+		{"15:04:05.000000", "١٣:٠٤:٠٥.١٢٠٠٠٠"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.layout, func(t *testing.T) {
+			result, err := k.KFormat(tt.layout)
+			if err != nil {
+				t.Fatalf("KFormat() unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("KFormat(%q) = %q, expected %q", tt.layout, result, tt.expected)
+			}
+		})
+	}
+
+	am := GregorianToKurdish(time.Date(2023, 3, 21, 0, 30, 0, 0, time.UTC), Sorani, MedianKingdom)
+	if result, _ := am.KFormat("3:04 PM"); result != "١٢:٣٠ پێش نیوەڕۆ" {
+		t.Errorf("KFormat() = %q, expected %q", result, "١٢:٣٠ پێش نیوەڕۆ")
+	}
+}
+
+func TestGregorianToKurdishDateE(t *testing.T) {
+	var (
+		dateErr    *ErrorInvalidDate
+		yearErr    *ErrorInvalidYear
+		dialectErr *ErrorInvalidDialect
+		epochErr   *ErrorInvalidEpoch
+	)
+	tests := []struct {
+		name    string
+		year    int
+		month   int
+		day     int
+		dialect Dialect
+		epoch   Epoch
+		target  interface{}
+	}{
+		{"February 30", 2023, 2, 30, Sorani, MedianKingdom, &dateErr},
+		{"Month 13", 2023, 13, 1, Sorani, MedianKingdom, &dateErr},
+		{"Day 0", 2023, 1, 0, Sorani, MedianKingdom, &dateErr},
+		{"Year before range", 100, 3, 21, Sorani, MedianKingdom, &yearErr},
+		{"Year after range", 5000, 3, 21, Sorani, MedianKingdom, &yearErr},
+		{"Unknown dialect", 2023, 3, 21, Dialect(42), MedianKingdom, &dialectErr},
+		{"Unknown epoch", 2023, 3, 21, Sorani, Epoch(-1), &epochErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := GregorianToKurdishDateE(tt.year, tt.month, tt.day, tt.dialect, tt.epoch)
+			if err == nil {
+				t.Fatalf("GregorianToKurdishDateE() expected error, got none")
+			}
+			if !errors.As(err, tt.target) {
+				t.Errorf("GregorianToKurdishDateE() error = %T (%v), expected %T", err, err, tt.target)
+			}
+			// The non-error variant must not panic and returns the zero date.
+			if k := GregorianToKurdishDate(tt.year, tt.month, tt.day, tt.dialect, tt.epoch); k != (KurdishDate{}) {
+				t.Errorf("GregorianToKurdishDate() = %v, expected zero date", k)
+			}
+		})
+	}
+}
+
+func TestGregorianToKurdishDateEYear(t *testing.T) {
+	// The error reports the Kurdish year, as the other conversions do.
+	_, err := GregorianToKurdishDateE(5000, 6, 1, Sorani, MedianKingdom)
+	var yearErr *ErrorInvalidYear
+	if !errors.As(err, &yearErr) {
+		t.Fatalf("GregorianToKurdishDateE() error = %v, expected *ErrorInvalidYear", err)
+	}
+	if yearErr.Year != 5700 {
+		t.Errorf("ErrorInvalidYear.Year = %d, expected 5700", yearErr.Year)
+	}
+}
+
+func TestGregorianToKurdishE(t *testing.T) {
+	input := time.Date(2023, 3, 21, 10, 0, 0, 0, time.UTC)
+	k, err := GregorianToKurdishE(input, Sorani, MedianKingdom)
+	if err != nil {
+		t.Fatalf("GregorianToKurdishE() unexpected error: %v", err)
+	}
+	if k != GregorianToKurdish(input, Sorani, MedianKingdom) {
+		t.Errorf("GregorianToKurdishE() = %v, differs from GregorianToKurdish()", k)
+	}
+	if _, err := GregorianToKurdishE(time.Date(9000, 1, 1, 0, 0, 0, 0, time.UTC), Sorani, MedianKingdom); err == nil {
+		t.Errorf("GregorianToKurdishE() expected error for year 9000, got none")
+	}
+}
+
+func TestKurdishToGregorianInvalidEpoch(t *testing.T) {
+	_, _, _, err := KurdishToGregorianDate(2723, 1, 1, Epoch(7))
+	var epochErr *ErrorInvalidEpoch
+	if !errors.As(err, &epochErr) {
+		t.Errorf("KurdishToGregorianDate() error = %v, expected ErrorInvalidEpoch", err)
+	}
+}
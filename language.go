@@ -0,0 +1,183 @@
+package kurdical
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// scriptSubtags holds the ISO 15924 codes used as BCP 47 script subtags.
+var scriptSubtags = map[Script]string{
+	ArabicScript: "Arab",
+	LatinScript:  "Latn",
+}
+
+// tagScripts holds the script that a language tag without a script subtag
+// is taken to mean. It is the script the language is most often written
+// in, which for Kurmanji differs from the script of its names in the
+// locale data.
+var tagScripts = map[string]Script{
+	"kmr": LatinScript,
+	"ckb": ArabicScript,
+	"sdh": ArabicScript,
+	"lki": ArabicScript,
+	"hac": ArabicScript,
+}
+
+// tagScript returns the script meant by the language tag of loc without a
+// script subtag: the one in tagScripts, or else the locale's own script.
+func (loc *locale) tagScript() Script {
+	if s, ok := tagScripts[loc.tag]; ok {
+		return s
+	}
+	return loc.script
+}
+
+// ISO6393 returns the ISO 639-3 code of the dialect, such as "ckb" for
+// Sorani, or "" if the dialect has none.
+func (d Dialect) ISO6393() string {
+	loc, ok := lookupLocale(d)
+	if !ok || len(loc.tag) != 3 {
+		return ""
+	}
+	return loc.tag
+}
+
+// LanguageTag returns the BCP 47 language tag of the dialect written in a
+// script, such as "ckb" for Sorani in Arabic script or "ckb-Latn" in Latin
+// script. The script subtag is left out for the script the language is
+// usually written in, which is Latin for Kurmanji and Arabic for the other
+// built-in dialects.
+// It returns "" if the dialect has no tag.
+func (d Dialect) LanguageTag(script Script) string {
+	loc, ok := lookupLocale(d)
+	if !ok || loc.tag == "" {
+		return ""
+	}
+	if script == loc.tagScript() {
+		return loc.tag
+	}
+	sub, ok := scriptSubtags[script]
+	if !ok {
+		return loc.tag
+	}
+	return loc.tag + "-" + sub
+}
+
+// ParseLanguageTag returns the dialect and script of a BCP 47 language
+// tag, such as "kmr", "ckb-Latn" or "sdh-IR". Case is ignored, and region
+// and other subtags are accepted but not used. Without a script subtag,
+// the script the language is usually written in is returned: Latin for
+// Kurmanji, as in Turkey, Syria and most of the diaspora, and Arabic for
+// the other built-in dialects. A custom dialect gets the script of its
+// Locale.
+//
+// The macrolanguage tag "ku" is taken to be Kurmanji, or Sorani when it
+// has the script subtag "Arab".
+func ParseLanguageTag(tag string) (Dialect, Script, error) {
+	subtags := strings.FieldsFunc(tag, func(r rune) bool { return r == '-' || r == '_' })
+	if len(subtags) == 0 {
+		return 0, 0, &ErrorParse{Value: tag, Message: ": empty language tag"}
+	}
+	var (
+		script    Script
+		hasScript bool
+	)
+	if len(subtags) > 1 && len(subtags[1]) == 4 {
+		for s, sub := range scriptSubtags {
+			if strings.EqualFold(sub, subtags[1]) {
+				script, hasScript = s, true
+			}
+		}
+	}
+
+	lang := strings.ToLower(subtags[0])
+	if lang == "ku" {
+		lang = "kmr"
+		if hasScript && script == ArabicScript {
+			lang = "ckb"
+		}
+	}
+	localesMu.RLock()
+	d, loc := findTag(lang)
+	localesMu.RUnlock()
+	if loc == nil {
+		return 0, 0, &ErrorParse{Value: tag, Message: ": no dialect for language " + strconv.Quote(subtags[0])}
+	}
+	if !hasScript {
+		script = loc.tagScript()
+	}
+	return d, script, nil
+}
+
+// NegotiateDialect picks the dialect and script that best match an HTTP
+// Accept-Language header, such as "ckb-IQ, kmr;q=0.8, en;q=0.5".
+// Languages are tried in order of decreasing quality, and the first one
+// that names a known dialect wins. It reports false if none does, in which
+// case the caller should fall back to its default dialect.
+func NegotiateDialect(acceptLanguage string) (Dialect, Script, bool) {
+	type choice struct {
+		tag string
+		q   float64
+	}
+	var choices []choice
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		fields := strings.Split(part, ";")
+		tag := strings.TrimSpace(fields[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if len(param) > 2 && (param[:2] == "q=" || param[:2] == "Q=") {
+				v, err := strconv.ParseFloat(param[2:], 64)
+				if err != nil {
+					v = 0
+				}
+				q = v
+			}
+		}
+		if q <= 0 {
+			continue
+		}
+		choices = append(choices, choice{tag, q})
+	}
+	sort.SliceStable(choices, func(i, j int) bool { return choices[i].q > choices[j].q })
+
+	for _, c := range choices {
+		if d, script, err := ParseLanguageTag(c.tag); err == nil {
+			return d, script, true
+		}
+	}
+	return 0, 0, false
+}
+
+// findTag returns the dialect and locale with the given language tag,
+// ignoring case, or a nil locale if there is none. localesMu must be held.
+func findTag(tag string) (Dialect, *locale) {
+	if tag == "" {
+		return 0, nil
+	}
+	for d, loc := range locales {
+		if strings.EqualFold(loc.tag, tag) {
+			return d, loc
+		}
+	}
+	return 0, nil
+}
+
+// isLanguageSubtag reports whether s is a well-formed BCP 47 primary
+// language subtag: 2 to 8 ASCII letters.
+func isLanguageSubtag(s string) bool {
+	if len(s) < 2 || len(s) > 8 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i] | 0x20 // lower case
+		if c < 'a' || c > 'z' {
+			return false
+		}
+	}
+	return true
+}
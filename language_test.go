@@ -0,0 +1,107 @@
+package kurdical
+
+import "testing"
+
+func TestLanguageTag(t *testing.T) {
+	tests := []struct {
+		dialect Dialect
+		iso     string
+		arabic  string
+		latin   string
+	}{
+		{Sorani, "ckb", "ckb", "ckb-Latn"},
+		{Kurmanji, "kmr", "kmr-Arab", "kmr"},
+		{Kalhuri, "sdh", "sdh", "sdh-Latn"},
+		{Laki, "lki", "lki", "lki-Latn"},
+		{Hawrami, "hac", "hac", "hac-Latn"},
+		{Dialect(99), "", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dialect.String(), func(t *testing.T) {
+			if got := tt.dialect.ISO6393(); got != tt.iso {
+				t.Errorf("ISO6393() = %q, expected %q", got, tt.iso)
+			}
+			if got := tt.dialect.LanguageTag(ArabicScript); got != tt.arabic {
+				t.Errorf("LanguageTag(ArabicScript) = %q, expected %q", got, tt.arabic)
+			}
+			if got := tt.dialect.LanguageTag(LatinScript); got != tt.latin {
+				t.Errorf("LanguageTag(LatinScript) = %q, expected %q", got, tt.latin)
+			}
+			if tt.iso == "" {
+				return
+			}
+			for script, tag := range map[Script]string{ArabicScript: tt.arabic, LatinScript: tt.latin} {
+				d, s, err := ParseLanguageTag(tag)
+				if err != nil || d != tt.dialect || s != script {
+					t.Errorf("ParseLanguageTag(%q) = %v, %d, %v, expected %v, %d", tag, d, s, err, tt.dialect, script)
+				}
+			}
+		})
+	}
+}
+
+func TestParseLanguageTag(t *testing.T) {
+	tests := []struct {
+		tag     string
+		dialect Dialect
+		script  Script
+		wantErr bool
+	}{
+		{"ckb", Sorani, ArabicScript, false},
+		{"CKB-IQ", Sorani, ArabicScript, false},
+		{"ckb_Latn", Sorani, LatinScript, false},
+		{"kmr-TR", Kurmanji, LatinScript, false},
+		{"sdh-Arab-IR", Kalhuri, ArabicScript, false},
+		{"ku", Kurmanji, LatinScript, false},
+		{"ku-Latn", Kurmanji, LatinScript, false},
+		{"ku-Arab", Sorani, ArabicScript, false},
+		{"fa-IR", 0, 0, true},
+		{"", 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			d, s, err := ParseLanguageTag(tt.tag)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseLanguageTag(%q) expected error, got none", tt.tag)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseLanguageTag(%q) unexpected error: %v", tt.tag, err)
+			}
+			if d != tt.dialect || s != tt.script {
+				t.Errorf("ParseLanguageTag(%q) = %v, %d, expected %v, %d", tt.tag, d, s, tt.dialect, tt.script)
+			}
+		})
+	}
+}
+
+func TestNegotiateDialect(t *testing.T) {
+	tests := []struct {
+		header  string
+		dialect Dialect
+		script  Script
+		ok      bool
+	}{
+		{"ckb", Sorani, ArabicScript, true},
+		{"en-US,en;q=0.9,kmr;q=0.8", Kurmanji, LatinScript, true},
+		{"kmr-Latn-TR", Kurmanji, LatinScript, true},
+		{"sdh;q=0.5, lki;q=0.7", Laki, ArabicScript, true},
+		{"hac;q=0, ckb-Latn;q=0.1", Sorani, LatinScript, true},
+		{"ku-Arab, ku-Latn", Sorani, ArabicScript, true},
+		{"*, fa", 0, 0, false},
+		{"", 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			d, s, ok := NegotiateDialect(tt.header)
+			if ok != tt.ok || d != tt.dialect || s != tt.script {
+				t.Errorf("NegotiateDialect(%q) = %v, %d, %t, expected %v, %d, %t", tt.header, d, s, ok, tt.dialect, tt.script, tt.ok)
+			}
+		})
+	}
+}
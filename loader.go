@@ -0,0 +1,451 @@
+package kurdical
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// localeFiles holds the data of the built-in dialects, one file per dialect.
+//
+//go:embed locales/*.json
+var localeFiles embed.FS
+
+// builtinDialects maps the built-in dialects to their data files.
+var builtinDialects = map[Dialect]string{
+	Laki:     "locales/laki.json",
+	Hawrami:  "locales/hawrami.json",
+	Sorani:   "locales/sorani.json",
+	Kalhuri:  "locales/kalhuri.json",
+	Kurmanji: "locales/kurmanji.json",
+}
+
+// localeFile is the JSON form of a locale.
+//
+// Every field is optional when the file overrides a known dialect: only
+// the names it gives are replaced.
+type localeFile struct {
+	Name        string     `json:"name"`
+	Tag         string     `json:"tag"`
+	Script      string     `json:"script"`
+	Digits      string     `json:"digits"`
+	PeriodUnits []string   `json:"periodUnits"`
+	Arabic      *namesFile `json:"arabic"`
+	Latin       *namesFile `json:"latin"`
+}
+
+// namesFile is the JSON form of the names of a locale in one script.
+type namesFile struct {
+	Months        []string `json:"months"`
+	ShortMonths   []string `json:"shortMonths"`
+	Weekdays      []string `json:"weekdays"`
+	ShortWeekdays []string `json:"shortWeekdays"`
+	AM            string   `json:"am"`
+	PM            string   `json:"pm"`
+
+	GregorianMonths      []string `json:"gregorianMonths"`
+	ShortGregorianMonths []string `json:"shortGregorianMonths"`
+
+	Ordinals        []string `json:"ordinals"`
+	Ezafe           string   `json:"ezafe"`
+	EzafeAfterVowel string   `json:"ezafeAfterVowel"`
+	YearPrefix      string   `json:"yearPrefix"`
+
+	Seasons           []string `json:"seasons"`
+	Era               string   `json:"era"`
+	ShortEra          string   `json:"shortEra"`
+	GregorianEra      string   `json:"gregorianEra"`
+	ShortGregorianEra string   `json:"shortGregorianEra"`
+	LeapYear          string   `json:"leapYear"`
+
+	PeriodUnits     []string `json:"periodUnits"`
+	PeriodSeparator string   `json:"periodSeparator"`
+}
+
+// scriptNames and digitNames are the JSON spellings of Script and DigitSystem.
+var (
+	scriptNames = map[string]Script{
+		"arabic": ArabicScript,
+		"latin":  LatinScript,
+	}
+	digitNames = map[string]DigitSystem{
+		"default":        DefaultDigits,
+		"eastern-arabic": EasternArabicDigits,
+		"persian":        PersianDigits,
+		"latin":          LatinDigits,
+	}
+)
+
+// LoadLocale reads a locale in JSON form from r. If its name is that of a
+// known dialect, built-in or registered, the names given in the file
+// replace those of the dialect; otherwise the locale is registered as a
+// new dialect. It returns the dialect the locale was loaded as.
+//
+// The file has the form of the built-in data files:
+//
+//	{
+//	  "name": "Feyli",
+//	  "tag": "sdh",
+//	  "script": "arabic",
+//	  "digits": "default",
+//	  "arabic": {
+//	    "months": ["...", ...],
+//	    "shortMonths": ["...", ...],
+//	    "weekdays": ["...", ...],
+//	    "shortWeekdays": ["...", ...],
+//	    "gregorianMonths": ["...", ...],
+//	    "shortGregorianMonths": ["...", ...],
+//	    "ordinals": ["...", ...],
+//	    "ezafe": "ی",
+//	    "ezafeAfterVowel": "ی",
+//	    "yearPrefix": "...",
+//	    "seasons": ["...", ...],
+//	    "era": "...",
+//	    "shortEra": "...",
+//	    "gregorianEra": "...",
+//	    "shortGregorianEra": "...",
+//	    "leapYear": "...",
+//	    "am": "...",
+//	    "pm": "...",
+//	    "periodUnits": ["ساڵ", "مانگ", "ڕووژ"],
+//	    "periodSeparator": " و "
+//	  },
+//	  "latin": {...}
+//	}
+//
+// A "periodUnits" entry at the top level, as in files written for earlier
+// versions, gives the period units of the locale's script.
+//
+// Errors are of type *ErrorInvalidLocale and name the offending entry,
+// such as "arabic.months[3]".
+func LoadLocale(r io.Reader) (Dialect, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return 0, err
+	}
+	f, err := decodeLocaleFile(data)
+	if err != nil {
+		return 0, err
+	}
+
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	d, base := findLocale(f.Name)
+	loc, err := compileLocale(f, base, locales[Sorani])
+	if err != nil {
+		return 0, err
+	}
+	if td, other := findTag(loc.tag); other != nil && (base == nil || td != d) {
+		return 0, &ErrorInvalidLocale{Name: loc.name, Field: "tag", Message: fmt.Sprintf("already used by %s", other.name)}
+	}
+	if base == nil {
+		d = nextDialect
+		nextDialect++
+	}
+	locales[d] = loc
+	return d, nil
+}
+
+// LoadLocaleFile is like LoadLocale but reads the named file.
+// Errors about the file's contents carry its name.
+func LoadLocaleFile(name string) (Dialect, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	d, err := LoadLocale(file)
+	var localeErr *ErrorInvalidLocale
+	if errors.As(err, &localeErr) {
+		localeErr.File = name
+	}
+	return d, err
+}
+
+// findLocale returns the dialect and locale with the given name, ignoring
+// case, or a nil locale if there is none. localesMu must be held.
+func findLocale(name string) (Dialect, *locale) {
+	for d, loc := range locales {
+		if strings.EqualFold(loc.name, name) {
+			return d, loc
+		}
+	}
+	return 0, nil
+}
+
+// decodeLocaleFile decodes and checks the structure of a locale file.
+func decodeLocaleFile(data []byte) (localeFile, error) {
+	var f localeFile
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&f); err != nil {
+		var (
+			syntaxErr *json.SyntaxError
+			typeErr   *json.UnmarshalTypeError
+		)
+		switch {
+		case errors.As(err, &syntaxErr):
+			// The offset is just past the offending character.
+			line, col := position(data, syntaxErr.Offset-1)
+			return f, &ErrorInvalidLocale{Message: fmt.Sprintf("syntax error at line %d, column %d: %v", line, col, err)}
+		case errors.As(err, &typeErr):
+			line, col := position(data, typeErr.Offset)
+			return f, &ErrorInvalidLocale{Name: f.Name, Field: typeErr.Field, Message: fmt.Sprintf("must be %s, not %s (line %d, column %d)", jsonType(typeErr.Type.String()), typeErr.Value, line, col)}
+		}
+		return f, &ErrorInvalidLocale{Name: f.Name, Message: err.Error()}
+	}
+	if dec.More() {
+		return f, &ErrorInvalidLocale{Name: f.Name, Message: "extra data after the locale object"}
+	}
+	if f.Script != "" {
+		if _, ok := scriptNames[f.Script]; !ok {
+			return f, &ErrorInvalidLocale{Name: f.Name, Field: "script", Message: fmt.Sprintf("unknown script %q", f.Script)}
+		}
+	}
+	if f.Digits != "" {
+		if _, ok := digitNames[f.Digits]; !ok {
+			return f, &ErrorInvalidLocale{Name: f.Name, Field: "digits", Message: fmt.Sprintf("unknown digit system %q", f.Digits)}
+		}
+	}
+	if f.PeriodUnits != nil && len(f.PeriodUnits) != 3 {
+		return f, &ErrorInvalidLocale{Name: f.Name, Field: "periodUnits", Message: fmt.Sprintf("has %d words, expected 3", len(f.PeriodUnits))}
+	}
+	for script, n := range []*namesFile{f.Arabic, f.Latin} {
+		if n != nil && n.PeriodUnits != nil && len(n.PeriodUnits) != 3 {
+			return f, &ErrorInvalidLocale{Name: f.Name, Field: jsonScript(Script(script)) + ".periodUnits", Message: fmt.Sprintf("has %d words, expected 3", len(n.PeriodUnits))}
+		}
+	}
+	return f, nil
+}
+
+// compileLocale builds the locale described by f. If base is not nil, f
+// overrides it and fields missing from f are taken from base. Optional
+// names missing from both are taken from def, if it is not nil.
+func compileLocale(f localeFile, base, def *locale) (*locale, error) {
+	var l [2]Locale // indexed by Script
+	for script := range l {
+		l[script] = Locale{Name: f.Name, Script: Script(script)}
+	}
+	var have [2]bool
+	if base != nil {
+		for script, n := range base.scripts {
+			if n != nil {
+				l[script] = base.export(Script(script))
+				have[script] = true
+			}
+		}
+		for script := range l {
+			l[script].Name = base.name
+			l[script].Script = Script(script)
+		}
+	}
+
+	primary := ArabicScript
+	if base != nil {
+		primary = base.script
+	}
+	if f.Script != "" {
+		primary = scriptNames[f.Script]
+	}
+	digits := DefaultDigits
+	if base != nil {
+		digits = base.digits
+	}
+	if f.Digits != "" {
+		digits = digitNames[f.Digits]
+	}
+	if f.PeriodUnits != nil {
+		copy(l[primary].PeriodUnits[:], f.PeriodUnits)
+	}
+	for script, n := range []*namesFile{f.Arabic, f.Latin} {
+		if n == nil {
+			continue
+		}
+		n.mergeInto(&l[script])
+		have[script] = true
+	}
+	if !have[primary] {
+		return nil, &ErrorInvalidLocale{Name: l[primary].Name, Field: jsonScript(primary), Message: "must be given for the locale's script"}
+	}
+
+	tag := f.Tag
+	if tag == "" && base != nil {
+		tag = base.tag
+	}
+	main := l[primary]
+	main.Tag, main.Digits = tag, digits
+	loc, err := newLocale(main, def)
+	if err != nil {
+		return nil, jsonField(err, primary)
+	}
+	for script := range l {
+		if Script(script) == primary || !have[script] {
+			continue
+		}
+		var defNames *names
+		if def != nil {
+			defNames, _ = def.namesIn(Script(script))
+		}
+		n, err := newNames(l[script], defNames)
+		if err != nil {
+			return nil, jsonField(err, Script(script))
+		}
+		loc.scripts[script] = n
+	}
+	loc.spellNames()
+	return loc, nil
+}
+
+// mergeInto copies the names given in n into l.
+func (n *namesFile) mergeInto(l *Locale) {
+	if n.Months != nil {
+		l.Months = n.Months
+	}
+	if n.ShortMonths != nil {
+		l.ShortMonths = n.ShortMonths
+	}
+	if n.Weekdays != nil {
+		l.Weekdays = n.Weekdays
+	}
+	if n.ShortWeekdays != nil {
+		l.ShortWeekdays = n.ShortWeekdays
+	}
+	if n.GregorianMonths != nil {
+		l.GregorianMonths = n.GregorianMonths
+	}
+	if n.ShortGregorianMonths != nil {
+		l.ShortGregorianMonths = n.ShortGregorianMonths
+	}
+	if n.Ordinals != nil {
+		l.Ordinals = n.Ordinals
+	}
+	if n.Ezafe != "" {
+		l.Ezafe = n.Ezafe
+	}
+	if n.EzafeAfterVowel != "" {
+		l.EzafeAfterVowel = n.EzafeAfterVowel
+	}
+	if n.YearPrefix != "" {
+		l.YearPrefix = n.YearPrefix
+	}
+	if n.Seasons != nil {
+		l.Seasons = n.Seasons
+	}
+	if n.Era != "" {
+		l.Era = n.Era
+	}
+	if n.ShortEra != "" {
+		l.ShortEra = n.ShortEra
+	}
+	if n.GregorianEra != "" {
+		l.GregorianEra = n.GregorianEra
+	}
+	if n.ShortGregorianEra != "" {
+		l.ShortGregorianEra = n.ShortGregorianEra
+	}
+	if n.LeapYear != "" {
+		l.LeapYear = n.LeapYear
+	}
+	if n.AM != "" {
+		l.AM = n.AM
+	}
+	if n.PM != "" {
+		l.PM = n.PM
+	}
+	if n.PeriodUnits != nil {
+		copy(l.PeriodUnits[:], n.PeriodUnits)
+	}
+	if n.PeriodSeparator != "" {
+		l.PeriodSeparator = n.PeriodSeparator
+	}
+}
+
+// jsonField rewrites the Go field name of a locale error, such as
+// "Months[3]", as the path of the entry in a locale file, such as
+// "arabic.months[3]".
+func jsonField(err error, script Script) error {
+	var localeErr *ErrorInvalidLocale
+	if !errors.As(err, &localeErr) || localeErr.Field == "" {
+		return err
+	}
+	field := strings.ToLower(localeErr.Field[:1]) + localeErr.Field[1:]
+	switch {
+	case field == "aM" || field == "pM":
+		field = jsonScript(script) + "." + strings.ToLower(field)
+	case strings.HasPrefix(field, "months"), strings.HasPrefix(field, "shortMonths"),
+		strings.HasPrefix(field, "weekdays"), strings.HasPrefix(field, "shortWeekdays"),
+		strings.HasPrefix(field, "gregorianMonths"), strings.HasPrefix(field, "shortGregorianMonths"),
+		strings.HasPrefix(field, "ordinals"), strings.HasPrefix(field, "seasons"),
+		strings.HasPrefix(field, "periodUnits"):
+		field = jsonScript(script) + "." + field
+	}
+	localeErr.Field = field
+	return localeErr
+}
+
+// jsonScript returns the JSON spelling of a script.
+func jsonScript(script Script) string {
+	if script == LatinScript {
+		return "latin"
+	}
+	return "arabic"
+}
+
+// jsonType returns the JSON name of a Go type in a decoding error.
+func jsonType(goType string) string {
+	switch {
+	case goType == "string":
+		return "a string"
+	case strings.HasPrefix(goType, "[]"):
+		return "an array"
+	}
+	return "an object"
+}
+
+// position returns the line and column, counted from 1, of a byte offset
+// in data.
+func position(data []byte, offset int64) (line, col int) {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	before := data[:offset]
+	line = bytes.Count(before, []byte("\n")) + 1
+	col = int(offset) - bytes.LastIndexByte(before, '\n')
+	return line, col
+}
+
+// builtinLocales returns the locales of the built-in dialects, read from
+// the embedded data files.
+func builtinLocales() map[Dialect]*locale {
+	m := make(map[Dialect]*locale, len(builtinDialects))
+	// Sorani comes first, as the other dialects take the optional names
+	// they lack from it.
+	for _, d := range []Dialect{Sorani, Laki, Hawrami, Kalhuri, Kurmanji} {
+		name := builtinDialects[d]
+		data, err := localeFiles.ReadFile(name)
+		if err != nil {
+			panic(err)
+		}
+		f, err := decodeLocaleFile(data)
+		if err == nil {
+			m[d], err = compileLocale(f, nil, m[Sorani])
+		}
+		if err != nil {
+			var localeErr *ErrorInvalidLocale
+			if errors.As(err, &localeErr) {
+				localeErr.File = name
+			}
+			panic(err)
+		}
+	}
+	return m
+}
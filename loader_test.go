@@ -0,0 +1,143 @@
+package kurdical
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestBuiltinLocaleFiles(t *testing.T) {
+	files, err := fs.Glob(localeFiles, "locales/*.json")
+	if err != nil {
+		t.Fatalf("fs.Glob() unexpected error: %v", err)
+	}
+	if len(files) != len(builtinDialects) {
+		t.Errorf("found %d locale files, expected %d", len(files), len(builtinDialects))
+	}
+	for d, name := range builtinDialects {
+		loc, ok := LookupLocale(d)
+		if !ok {
+			t.Errorf("LookupLocale(%d) not found", d)
+			continue
+		}
+		if !strings.EqualFold("locales/"+loc.Name+".json", name) {
+			t.Errorf("dialect %d is named %q but read from %s", d, loc.Name, name)
+		}
+	}
+}
+
+func TestLoadLocale(t *testing.T) {
+	name := uniqueName("Bahdini")
+	src := `{
+  "name": "` + name + `",
+  "script": "latin",
+  "periodUnits": ["sal", "meh", "roj"],
+  "latin": {
+    "months": ["Nîsan", "Gulan", "Hezîran", "Tîrmeh", "Tebax", "Îlon", "Çirî", "Mijdar", "Kanûn", "Çile", "Sibat", "Adar"],
+    "weekdays": ["Şemî", "Yekşem", "Duşem", "Sêşem", "Çarşem", "Pêncşem", "În"],
+    "am": "BN",
+    "pm": "PN"
+  }
+}`
+	d, err := LoadLocale(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("LoadLocale() unexpected error: %v", err)
+	}
+	k := GregorianToKurdishDate(2023, 10, 1, d, MedianKingdom)
+	if k.MonthName != "Çirî" {
+		t.Errorf("MonthName = %q, expected %q", k.MonthName, "Çirî")
+	}
+
+	// Loading a file with the same name overrides only the names it gives.
+	override := `{"name": "` + strings.ToUpper(name) + `", "latin": {"am": "berî nîvro", "pm": "piştî nîvro"}}`
+	again, err := LoadLocale(strings.NewReader(override))
+	if err != nil {
+		t.Fatalf("LoadLocale() unexpected error: %v", err)
+	}
+	if again != d {
+		t.Errorf("LoadLocale() = %d, expected dialect %d to be overridden", again, d)
+	}
+	loc, _ := LookupLocale(d)
+	if loc.Name != name || loc.AM != "berî nîvro" || loc.Months[6] != "Çirî" {
+		t.Errorf("LookupLocale() = %+v after override", loc)
+	}
+
+	// A dialect can gain names in a second script.
+	arabic := `{"name": "` + name + `", "arabic": {
+    "months": ["نیسان", "گوڵان", "حەزیران", "تیرمەه", "تەباخ", "ئیلون", "چری", "مژدار", "کانوون", "چلە", "سبات", "ئادار"],
+    "weekdays": ["شەمی", "یەکشەم", "دووشەم", "سێشەم", "چارشەم", "پێنجشەم", "ئەینی"],
+    "am": "پ.ن",
+    "pm": "د.ن"
+  }}`
+	if _, err := LoadLocale(strings.NewReader(arabic)); err != nil {
+		t.Fatalf("LoadLocale() unexpected error: %v", err)
+	}
+	k = GregorianToKurdishDate(2023, 10, 1, d, MedianKingdom)
+	if k.MonthName != "چری" {
+		t.Errorf("MonthName = %q, expected %q", k.MonthName, "چری")
+	}
+}
+
+func TestLoadLocaleErrors(t *testing.T) {
+	const names = `"months": ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l"],
+    "weekdays": ["1", "2", "3", "4", "5", "6", "7"], "am": "am", "pm": "pm"`
+	tests := []struct {
+		name    string
+		src     string
+		field   string
+		message string
+	}{
+		{"Syntax error", "{\n  \"name\": \"X\",\n  \"arabic\": [\n}", "", "line 4, column 1"},
+		{"Unknown field", `{"name": "X", "monts": []}`, "", `unknown field "monts"`},
+		{"Wrong type", `{"name": "X", "arabic": {"months": "a"}}`, "arabic.months", "must be an array"},
+		{"Unknown script", `{"name": "X", "script": "cyrillic"}`, "script", "unknown script"},
+		{"Unknown digits", `{"name": "X", "digits": "roman"}`, "digits", "unknown digit system"},
+		{"Two units", `{"name": "X", "periodUnits": ["a", "b"]}`, "periodUnits", "has 2 words"},
+		{"Two Latin units", `{"name": "X", "latin": {"periodUnits": ["a", "b"]}}`, "latin.periodUnits", "has 2 words"},
+		{"No names", `{"name": "X", "periodUnits": ["a", "b", "c"], "latin": {` + names + `}}`, "arabic", "must be given"},
+		{"No name", `{"periodUnits": ["a", "b", "c"], "arabic": {` + names + `}}`, "name", "must not be empty"},
+		{"No units", `{"name": "X", "arabic": {` + names + `}}`, "arabic.periodUnits[0]", "must not be empty"},
+		{"Empty month", `{"name": "X", "periodUnits": ["a", "b", "c"], "arabic": {` + strings.Replace(names, `"c"`, `""`, 1) + `}}`, "arabic.months[2]", "must not be empty"},
+		{"Short weekdays", `{"name": "X", "periodUnits": ["a", "b", "c"], "arabic": {` + names + `, "shortWeekdays": ["1"]}}`, "arabic.shortWeekdays", "has 1 names"},
+		{"Latin PM", `{"name": "X", "periodUnits": ["a", "b", "c"], "arabic": {` + names + `}, "latin": {` + strings.Replace(names, `"pm": "pm"`, `"pm": "am"`, 1) + `}}`, "latin.pm", "differ from AM"},
+		{"Ordinals", `{"name": "X", "periodUnits": ["a", "b", "c"], "arabic": {` + names + `, "ordinals": ["1", "1"]}}`, "arabic.ordinals", "has 2 names"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadLocale(strings.NewReader(tt.src))
+			var localeErr *ErrorInvalidLocale
+			if !errors.As(err, &localeErr) {
+				t.Fatalf("LoadLocale() error = %v, expected ErrorInvalidLocale", err)
+			}
+			if localeErr.Field != tt.field {
+				t.Errorf("LoadLocale() error field = %q, expected %q", localeErr.Field, tt.field)
+			}
+			if !strings.Contains(err.Error(), tt.message) {
+				t.Errorf("LoadLocale() error = %q, expected it to contain %q", err, tt.message)
+			}
+		})
+	}
+}
+
+func TestLoadLocaleFile(t *testing.T) {
+	const name = "testdata/bad_locale.json"
+	_, err := LoadLocaleFile(name)
+	var localeErr *ErrorInvalidLocale
+	if !errors.As(err, &localeErr) {
+		t.Fatalf("LoadLocaleFile() error = %v, expected ErrorInvalidLocale", err)
+	}
+	if localeErr.File != name || localeErr.Field != "arabic.months[11]" {
+		t.Errorf("LoadLocaleFile() error = %+v", localeErr)
+	}
+	expected := `testdata/bad_locale.json: invalid locale "Bad": arabic.months[11] duplicates entry 10 "k"`
+	if err.Error() != expected {
+		t.Errorf("LoadLocaleFile() error = %q, expected %q", err, expected)
+	}
+
+	if _, err := LoadLocaleFile("testdata/missing.json"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("LoadLocaleFile() error = %v, expected os.ErrNotExist", err)
+	}
+}
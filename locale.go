@@ -0,0 +1,442 @@
+package kurdical
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Locale describes the names and conventions of a dialect. It is used to
+// register custom dialects with RegisterDialect and is returned by
+// LookupLocale. LoadLocale reads the same information from a JSON file.
+type Locale struct {
+	// Name identifies the dialect, such as "Feyli". It must be unique
+	// among registered dialects, ignoring case.
+	Name string
+	// Tag is the BCP 47 language tag of the dialect, such as "ckb", written
+	// without a script subtag: the tag stands for the dialect written in
+	// Script. It is optional, and must be unique among registered dialects.
+	Tag string
+	// Script is the alphabet the names are written in.
+	Script Script
+	// Digits is the digit system used when FormatOptions asks for DefaultDigits.
+	Digits DigitSystem
+	// Months holds the 12 month names, starting with the month of Newroz.
+	Months []string
+	// ShortMonths holds 12 abbreviated month names for the "Jan" layout
+	// token. If nil, Months is used.
+	ShortMonths []string
+	// Weekdays holds the 7 weekday names, starting with Saturday.
+	Weekdays []string
+	// ShortWeekdays holds 7 abbreviated weekday names for the "Mon" layout
+	// token. If nil, Weekdays is used.
+	ShortWeekdays []string
+	// GregorianMonths holds the 12 names of the Gregorian months, starting
+	// with January, used by KFormatGregorian. It is optional; if nil, the
+	// Sorani names are used.
+	GregorianMonths []string
+	// ShortGregorianMonths holds 12 abbreviated Gregorian month names for
+	// the "Jan" layout token. If nil, GregorianMonths is used.
+	ShortGregorianMonths []string
+	// Ordinals holds the ordinal numbers from first to thirty-first, such
+	// as "یەکەم", written by the "2nd" layout token. If nil, the token
+	// writes the day as a number.
+	Ordinals []string
+	// Ezafe is the connector written by the "ـی" layout token after a number
+	// or a word that ends in a consonant, and EzafeAfterVowel the one written
+	// after a word that ends in a vowel. If empty, the Sorani ones are used.
+	Ezafe           string
+	EzafeAfterVowel string
+	// YearPrefix is the word written by the "Year" layout token, such as
+	// "ساڵی" in "ساڵی ٢٧٢٣". If empty, the Sorani word is used.
+	YearPrefix string
+	// Seasons holds the 4 season names, starting with spring, for the
+	// "Spring" layout token. If nil, the Sorani names are used.
+	Seasons []string
+	// Era and ShortEra name the era of Kurdish years, such as "کوردی" and
+	// "ک.", for the "Era" and "Er" layout tokens. GregorianEra and
+	// ShortGregorianEra name the era of Gregorian years, used by
+	// KFormatGregorian. If Era or GregorianEra is empty, the Sorani names
+	// are used; if only the short name is empty, the full one is used.
+	Era               string
+	ShortEra          string
+	GregorianEra      string
+	ShortGregorianEra string
+	// LeapYear is written by the "Leap" layout token in a leap year, such
+	// as "کەبیسە". If empty, the Sorani word is used.
+	LeapYear string
+	// AM and PM are the words for before and after noon.
+	AM string
+	PM string
+	// PeriodUnits holds the words for year, month and day used by
+	// Period.Format, and PeriodSeparator the word that joins the parts of
+	// a period. If PeriodSeparator is empty, " و " is used in Arabic script
+	// and " û " in Latin script.
+	PeriodUnits     [3]string
+	PeriodSeparator string
+}
+
+// names holds the names of a dialect in one script. The weekday slices
+// are indexed like WeekdayNames.
+type names struct {
+	script        Script
+	orthography   Orthography
+	months        []string
+	shortMonths   []string
+	weekdays      []string
+	shortWeekdays []string
+	am            string
+	pm            string
+
+	// gregorianMonths and shortGregorianMonths are nil if the locale has
+	// no Gregorian month names.
+	gregorianMonths      []string
+	shortGregorianMonths []string
+
+	// ordinals, ezafe, ezafeAfterVowel and yearPrefix are the words that
+	// join and count the parts of a date.
+	ordinals        []string
+	ezafe           string
+	ezafeAfterVowel string
+	yearPrefix      string
+
+	// seasons, the eras and leapYear are written by the tokens that
+	// describe the year and the season.
+	seasons           []string
+	era               string
+	shortEra          string
+	gregorianEra      string
+	shortGregorianEra string
+	leapYear          string
+
+	// periodUnits and periodSeparator are written by Period.Format. The
+	// units are empty if the locale has none in this script.
+	periodUnits     [3]string
+	periodSeparator string
+
+	// vowels holds the words of Arabic-script names whose final heh is the
+	// vowel ە, as found by findVowelWords, and finals records for every
+	// name whether it ends in a vowel, as found by findVowelFinals.
+	vowels map[string]bool
+	finals map[string]bool
+
+	// standard and legacy hold the names respelled in StandardOrthography
+	// and LegacyOrthography.
+	standard *names
+	legacy   *names
+}
+
+// locale is the registered form of a dialect. It is never modified after
+// registration, so it can be used without holding localesMu.
+type locale struct {
+	name    string
+	tag     string
+	script  Script
+	digits  DigitSystem
+	scripts [2]*names // indexed by Script
+}
+
+var (
+	localesMu   sync.RWMutex
+	locales     = builtinLocales()
+	nextDialect = Kurmanji + 1
+)
+
+// RegisterDialect validates l and registers it as a new dialect, which can
+// then be used everywhere a built-in Dialect is accepted. Dialect values
+// are assigned in registration order and are not stable across program
+// runs. It is safe to call RegisterDialect from multiple goroutines.
+func RegisterDialect(l Locale) (Dialect, error) {
+	def, _ := lookupLocale(Sorani)
+	loc, err := newLocale(l, def)
+	if err != nil {
+		return 0, err
+	}
+
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	if _, other := findLocale(l.Name); other != nil {
+		return 0, &ErrorInvalidLocale{Name: l.Name, Field: "Name", Message: "already registered"}
+	}
+	if _, other := findTag(l.Tag); other != nil {
+		return 0, &ErrorInvalidLocale{Name: l.Name, Field: "Tag", Message: fmt.Sprintf("already used by %s", other.name)}
+	}
+	d := nextDialect
+	nextDialect++
+	locales[d] = loc
+	return d, nil
+}
+
+// LookupLocale returns the locale of a dialect, with its names in the
+// dialect's own script, and reports whether the dialect is known.
+func LookupLocale(dialect Dialect) (Locale, bool) {
+	loc, ok := lookupLocale(dialect)
+	if !ok {
+		return Locale{}, false
+	}
+	return loc.export(loc.script), true
+}
+
+// export returns the locale with its names in a script, which must be
+// one the locale has names in.
+func (l *locale) export(script Script) Locale {
+	n := l.scripts[script]
+	return Locale{
+		Name:          l.name,
+		Tag:           l.tag,
+		Script:        script,
+		Digits:        l.digits,
+		Months:        append([]string(nil), n.months...),
+		ShortMonths:   append([]string(nil), n.shortMonths...),
+		Weekdays:      append([]string(nil), n.weekdays[1:]...),
+		ShortWeekdays: append([]string(nil), n.shortWeekdays[1:]...),
+		AM:            n.am,
+		PM:            n.pm,
+
+		PeriodUnits:     n.periodUnits,
+		PeriodSeparator: n.periodSeparator,
+
+		GregorianMonths:      append([]string(nil), n.gregorianMonths...),
+		ShortGregorianMonths: append([]string(nil), n.shortGregorianMonths...),
+
+		Ordinals:        append([]string(nil), n.ordinals...),
+		Ezafe:           n.ezafe,
+		EzafeAfterVowel: n.ezafeAfterVowel,
+		YearPrefix:      n.yearPrefix,
+
+		Seasons:           append([]string(nil), n.seasons...),
+		Era:               n.era,
+		ShortEra:          n.shortEra,
+		GregorianEra:      n.gregorianEra,
+		ShortGregorianEra: n.shortGregorianEra,
+		LeapYear:          n.leapYear,
+	}
+}
+
+// lookupLocale returns the registered locale of a dialect.
+func lookupLocale(dialect Dialect) (*locale, bool) {
+	localesMu.RLock()
+	loc, ok := locales[dialect]
+	localesMu.RUnlock()
+	return loc, ok
+}
+
+// lookupLocaleOrDefault is like lookupLocale but returns the Sorani locale
+// for an unknown dialect.
+func lookupLocaleOrDefault(dialect Dialect) *locale {
+	if loc, ok := lookupLocale(dialect); ok {
+		return loc
+	}
+	loc, _ := lookupLocale(Sorani)
+	return loc
+}
+
+// namesIn returns the names of the locale in a script, falling back to
+// the locale's own script if it has no names in the requested one.
+// Unknown scripts are treated as ArabicScript.
+func (l *locale) namesIn(script Script) (*names, Script) {
+	if script != LatinScript {
+		script = ArabicScript
+	}
+	if n := l.scripts[script]; n != nil {
+		return n, script
+	}
+	return l.scripts[l.script], l.script
+}
+
+// resolve returns the names, in the selected orthography, and the digit
+// zero selected by opts.
+func (l *locale) resolve(opts FormatOptions) (*names, rune) {
+	n, script := l.namesIn(opts.Script)
+	ds := opts.Digits
+	if ds == DefaultDigits {
+		ds = l.digits
+	}
+	if ds == DefaultDigits && script == LatinScript {
+		ds = LatinDigits
+	}
+	return n.in(opts.Orthography), ds.zero()
+}
+
+// newLocale validates l and returns its registered form. Optional names
+// missing from l are taken from def, if it is not nil.
+func newLocale(l Locale, def *locale) (*locale, error) {
+	if strings.TrimSpace(l.Name) == "" {
+		return nil, &ErrorInvalidLocale{Name: l.Name, Field: "Name", Message: "must not be empty"}
+	}
+	if l.Tag != "" && !isLanguageSubtag(l.Tag) {
+		return nil, &ErrorInvalidLocale{Name: l.Name, Field: "Tag", Message: fmt.Sprintf("%q is not a language subtag", l.Tag)}
+	}
+	if l.Script != ArabicScript && l.Script != LatinScript {
+		return nil, &ErrorInvalidLocale{Name: l.Name, Field: "Script", Message: fmt.Sprintf("unknown script %d", int(l.Script))}
+	}
+	if l.Digits < DefaultDigits || l.Digits > LatinDigits {
+		return nil, &ErrorInvalidLocale{Name: l.Name, Field: "Digits", Message: fmt.Sprintf("unknown digit system %d", int(l.Digits))}
+	}
+	for i, unit := range l.PeriodUnits {
+		if unit == "" {
+			return nil, &ErrorInvalidLocale{Name: l.Name, Field: fmt.Sprintf("PeriodUnits[%d]", i), Message: "must not be empty"}
+		}
+	}
+	var defNames *names
+	if def != nil {
+		defNames, _ = def.namesIn(l.Script)
+	}
+	n, err := newNames(l, defNames)
+	if err != nil {
+		return nil, err
+	}
+	loc := &locale{name: l.Name, tag: strings.ToLower(l.Tag), script: l.Script, digits: l.Digits}
+	loc.scripts[l.Script] = n
+	loc.spellNames()
+	return loc, nil
+}
+
+// newNames validates the names of l and returns them in registered form.
+// Optional names missing from l are taken from def, if it is not nil.
+// The period units may be left out, but not only some of them.
+func newNames(l Locale, def *names) (*names, error) {
+	if def != nil {
+		if l.GregorianMonths == nil {
+			l.GregorianMonths, l.ShortGregorianMonths = def.gregorianMonths, def.shortGregorianMonths
+		}
+		if l.Ezafe == "" {
+			l.Ezafe = def.ezafe
+		}
+		if l.EzafeAfterVowel == "" {
+			l.EzafeAfterVowel = def.ezafeAfterVowel
+		}
+		if l.YearPrefix == "" {
+			l.YearPrefix = def.yearPrefix
+		}
+		if l.Seasons == nil {
+			l.Seasons = def.seasons
+		}
+		if l.Era == "" {
+			l.Era, l.ShortEra = def.era, def.shortEra
+		}
+		if l.GregorianEra == "" {
+			l.GregorianEra, l.ShortGregorianEra = def.gregorianEra, def.shortGregorianEra
+		}
+		if l.LeapYear == "" {
+			l.LeapYear = def.leapYear
+		}
+	}
+	if l.ShortEra == "" {
+		l.ShortEra = l.Era
+	}
+	if l.ShortGregorianEra == "" {
+		l.ShortGregorianEra = l.GregorianEra
+	}
+	shortMonths, shortWeekdays := l.ShortMonths, l.ShortWeekdays
+	if shortMonths == nil {
+		shortMonths = l.Months
+	}
+	if shortWeekdays == nil {
+		shortWeekdays = l.Weekdays
+	}
+	gregorian, shortGregorian := l.GregorianMonths, l.ShortGregorianMonths
+	if shortGregorian == nil {
+		shortGregorian = gregorian
+	}
+	type table struct {
+		field string
+		tab   []string
+		size  int
+	}
+	tables := []table{
+		{"Months", l.Months, 12},
+		{"ShortMonths", shortMonths, 12},
+		{"Weekdays", l.Weekdays, 7},
+		{"ShortWeekdays", shortWeekdays, 7},
+	}
+	if shortGregorian != nil {
+		tables = append(tables, table{"GregorianMonths", gregorian, 12}, table{"ShortGregorianMonths", shortGregorian, 12})
+	}
+	if l.Ordinals != nil {
+		tables = append(tables, table{"Ordinals", l.Ordinals, 31})
+	}
+	if l.Seasons != nil {
+		tables = append(tables, table{"Seasons", l.Seasons, 4})
+	}
+	for _, tt := range tables {
+		if err := checkNames(l.Name, tt.field, tt.tab, tt.size); err != nil {
+			return nil, err
+		}
+	}
+	if l.AM == "" {
+		return nil, &ErrorInvalidLocale{Name: l.Name, Field: "AM", Message: "must not be empty"}
+	}
+	if l.PM == "" || l.PM == l.AM {
+		return nil, &ErrorInvalidLocale{Name: l.Name, Field: "PM", Message: "must be non-empty and differ from AM"}
+	}
+	if l.PeriodUnits != [3]string{} {
+		for i, unit := range l.PeriodUnits {
+			if unit == "" {
+				return nil, &ErrorInvalidLocale{Name: l.Name, Field: fmt.Sprintf("PeriodUnits[%d]", i), Message: "must not be empty"}
+			}
+		}
+	}
+	if l.PeriodSeparator == "" {
+		l.PeriodSeparator = periodSeparators[l.Script]
+	}
+
+	n := &names{
+		script:        l.Script,
+		months:        append([]string(nil), l.Months...),
+		shortMonths:   append([]string(nil), shortMonths...),
+		weekdays:      append([]string{""}, l.Weekdays...),
+		shortWeekdays: append([]string{""}, shortWeekdays...),
+		am:            l.AM,
+		pm:            l.PM,
+
+		gregorianMonths:      append([]string(nil), gregorian...),
+		shortGregorianMonths: append([]string(nil), shortGregorian...),
+
+		ordinals:        append([]string(nil), l.Ordinals...),
+		ezafe:           l.Ezafe,
+		ezafeAfterVowel: l.EzafeAfterVowel,
+		yearPrefix:      l.YearPrefix,
+
+		seasons:           append([]string(nil), l.Seasons...),
+		era:               l.Era,
+		shortEra:          l.ShortEra,
+		gregorianEra:      l.GregorianEra,
+		shortGregorianEra: l.ShortGregorianEra,
+		leapYear:          l.LeapYear,
+
+		periodUnits:     l.PeriodUnits,
+		periodSeparator: l.PeriodSeparator,
+	}
+	return n, nil
+}
+
+// in returns the names written in orthography o.
+func (n *names) in(o Orthography) *names {
+	switch o {
+	case StandardOrthography:
+		return n.standard
+	case LegacyOrthography:
+		return n.legacy
+	}
+	return n
+}
+
+// checkNames reports an error unless tab holds size distinct, non-empty
+// names, so that a parsed name identifies a single month or weekday.
+func checkNames(locale, field string, tab []string, size int) error {
+	if len(tab) != size {
+		return &ErrorInvalidLocale{Name: locale, Field: field, Message: fmt.Sprintf("has %d names, expected %d", len(tab), size)}
+	}
+	for i, name := range tab {
+		if strings.TrimSpace(name) == "" {
+			return &ErrorInvalidLocale{Name: locale, Field: fmt.Sprintf("%s[%d]", field, i), Message: "must not be empty"}
+		}
+		for j := 0; j < i; j++ {
+			if tab[j] == name {
+				return &ErrorInvalidLocale{Name: locale, Field: fmt.Sprintf("%s[%d]", field, i), Message: fmt.Sprintf("duplicates entry %d %q", j, name)}
+			}
+		}
+	}
+	return nil
+}
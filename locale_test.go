@@ -0,0 +1,209 @@
+package kurdical
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// localeSeq makes registered names unique when tests are run repeatedly
+// in one process, as with -count.
+var localeSeq int32
+
+// uniqueName returns name with a suffix that has not been registered yet.
+func uniqueName(name string) string {
+	return fmt.Sprintf("%s %d", name, atomic.AddInt32(&localeSeq, 1))
+}
+
+// feyliLocale returns an Arabic-script locale for registration tests.
+func feyliLocale(name string) Locale {
+	return Locale{
+		Name:        name,
+		Script:      ArabicScript,
+		Months:      []string{"جەژنان", "گوڵان", "زەردان", "پەرپەر", "گەلاویژ", "نوخشان", "بەران", "خەزان", "ساران", "بەفران", "بەندان", "ڕەمشان"},
+		Weekdays:    []string{"شەممە", "یەکشەممە", "دووشەممە", "سێشەممە", "چوارشەممە", "پەنجشەممە", "جمعە"},
+		AM:          "پ.ن",
+		PM:          "د.ن",
+		PeriodUnits: [3]string{"ساڵ", "مانگ", "ڕووژ"},
+	}
+}
+
+// zazakiLocale returns a Latin-script locale for registration tests.
+func zazakiLocale(name string) Locale {
+	return Locale{
+		Name:          name,
+		Script:        LatinScript,
+		Months:        []string{"Newroze", "Gulane", "Heziran", "Temuze", "Tebaxe", "Keşkelun", "Cıtane", "Payıze", "Sermawe", "Çele", "Sıbate", "Adare"},
+		ShortMonths:   []string{"New", "Gul", "Hez", "Tem", "Teb", "Keş", "Cıt", "Pay", "Ser", "Çel", "Sıb", "Ada"},
+		Weekdays:      []string{"Şeme", "Kırê", "Dışeme", "Şeşeme", "Çarşeme", "Panşeme", "Êne"},
+		ShortWeekdays: []string{"Şem", "Kır", "Dış", "Şeş", "Çar", "Pan", "Êne"},
+		AM:            "verê niyamroci",
+		PM:            "peyê niyamroci",
+		PeriodUnits:   [3]string{"serre", "aşme", "roce"},
+	}
+}
+
+func TestRegisterDialect(t *testing.T) {
+	feyli, err := RegisterDialect(feyliLocale(uniqueName("Feyli")))
+	if err != nil {
+		t.Fatalf("RegisterDialect() unexpected error: %v", err)
+	}
+	zazakiName := uniqueName("Zazaki")
+	zazaki, err := RegisterDialect(zazakiLocale(zazakiName))
+	if err != nil {
+		t.Fatalf("RegisterDialect() unexpected error: %v", err)
+	}
+	if feyli == zazaki || feyli <= Kurmanji || zazaki <= Kurmanji {
+		t.Fatalf("RegisterDialect() returned dialects %d and %d", feyli, zazaki)
+	}
+
+	// 2723/1/6 is a Sunday.
+	when := time.Date(2023, 3, 26, 15, 4, 0, 0, time.UTC)
+	const layout = "Monday Mon 2 January Jan 2006 3:04 PM"
+	tests := []struct {
+		name     string
+		dialect  Dialect
+		opts     FormatOptions
+		expected string
+	}{
+		{"Arabic script", feyli, FormatOptions{}, "یەکشەممە یەکشەممە ٦ جەژنان جەژنان ٢٧٢٣ ٣:٠٤ د.ن"},
+		{"Own script", zazaki, FormatOptions{}, "Kırê Kır 6 Newroze New 2723 3:04 peyê niyamroci"},
+		{"Explicit digits", zazaki, FormatOptions{Digits: EasternArabicDigits}, "Kırê Kır ٦ Newroze New ٢٧٢٣ ٣:٠٤ peyê niyamroci"},
+		{"Missing script", feyli, FormatOptions{Script: LatinScript}, "یەکشەممە یەکشەممە ٦ جەژنان جەژنان ٢٧٢٣ ٣:٠٤ د.ن"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, err := GregorianToKurdishE(when, tt.dialect, MedianKingdom)
+			if err != nil {
+				t.Fatalf("GregorianToKurdishE() unexpected error: %v", err)
+			}
+			result, err := k.KFormatWith(layout, tt.opts)
+			if err != nil {
+				t.Fatalf("KFormatWith() unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("KFormatWith() = %q, expected %q", result, tt.expected)
+			}
+
+			parsed, err := KParseWith(layout, result, tt.dialect, MedianKingdom, tt.opts)
+			if err != nil {
+				t.Fatalf("KParseWith() unexpected error: %v", err)
+			}
+			if parsed != k {
+				t.Errorf("KParseWith() = %v, expected %v", parsed, k)
+			}
+		})
+	}
+
+	k := GregorianToKurdishDate(2023, 3, 26, zazaki, MedianKingdom)
+	if k.MonthName != "Newroze" {
+		t.Errorf("MonthName = %q, expected %q", k.MonthName, "Newroze")
+	}
+	if got := WeekdayName(feyli, 7); got != "جمعە" {
+		t.Errorf("WeekdayName() = %q, expected %q", got, "جمعە")
+	}
+	if got := (Period{Years: 1, Days: 2}).Format(zazaki); got != "1 serre û 2 roce" {
+		t.Errorf("Period.Format() = %q, expected %q", got, "1 serre û 2 roce")
+	}
+
+	loc, ok := LookupLocale(zazaki)
+	if !ok || loc.Name != zazakiName || loc.Months[0] != "Newroze" || loc.Weekdays[6] != "Êne" {
+		t.Errorf("LookupLocale() = %+v, %t", loc, ok)
+	}
+}
+
+func TestRegisterDialectErrors(t *testing.T) {
+	withMonths := func(months []string) Locale {
+		l := feyliLocale("Bad months")
+		l.Months = months
+		return l
+	}
+	withPM := func(pm string) Locale {
+		l := feyliLocale("Bad PM")
+		l.PM = pm
+		return l
+	}
+	duplicate := feyliLocale("Bad duplicate")
+	duplicate.Weekdays = append([]string(nil), duplicate.Weekdays...)
+	duplicate.Weekdays[3] = duplicate.Weekdays[1]
+	badScript := feyliLocale("Bad script")
+	badScript.Script = Script(7)
+	noUnits := feyliLocale("Bad units")
+	noUnits.PeriodUnits[1] = ""
+	badTag := feyliLocale("Bad tag")
+	badTag.Tag = "sd-h"
+	usedTag := feyliLocale("Used tag")
+	usedTag.Tag = "CKB"
+	fewOrdinals := feyliLocale("Bad ordinals")
+	fewOrdinals.Ordinals = []string{"یەکەم", "دووەم"}
+	sameSeasons := feyliLocale("Bad seasons")
+	sameSeasons.Seasons = []string{"وەهار", "تاوسان", "پاییز", "پاییز"}
+
+	tests := []struct {
+		name   string
+		locale Locale
+		field  string
+	}{
+		{"Empty name", feyliLocale(" "), "Name"},
+		{"Built-in name", feyliLocale("sorani"), "Name"},
+		{"Too few months", withMonths([]string{"a", "b"}), "Months"},
+		{"Empty month", withMonths([]string{"a", "b", "c", "d", "e", "", "g", "h", "i", "j", "k", "l"}), "Months[5]"},
+		{"Duplicate weekday", duplicate, "Weekdays[3]"},
+		{"Same AM and PM", withPM("پ.ن"), "PM"},
+		{"Unknown script", badScript, "Script"},
+		{"Empty unit", noUnits, "PeriodUnits[1]"},
+		{"Malformed tag", badTag, "Tag"},
+		{"Tag in use", usedTag, "Tag"},
+		{"Too few ordinals", fewOrdinals, "Ordinals"},
+		{"Duplicate season", sameSeasons, "Seasons[3]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := RegisterDialect(tt.locale)
+			var localeErr *ErrorInvalidLocale
+			if !errors.As(err, &localeErr) {
+				t.Fatalf("RegisterDialect() error = %v, expected ErrorInvalidLocale", err)
+			}
+			if localeErr.Field != tt.field {
+				t.Errorf("RegisterDialect() error field = %q, expected %q", localeErr.Field, tt.field)
+			}
+		})
+	}
+}
+
+func TestRegisterDialectConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	dialects := make([]Dialect, 8)
+	for i := range dialects {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			d, err := RegisterDialect(feyliLocale(uniqueName("Concurrent")))
+			if err != nil {
+				t.Errorf("RegisterDialect() unexpected error: %v", err)
+			}
+			dialects[i] = d
+		}(i)
+		go func() {
+			defer wg.Done()
+			k := GregorianToKurdishDate(2023, 3, 21, Sorani, MedianKingdom)
+			if _, err := k.KFormat("Monday 2 January 2006"); err != nil {
+				t.Errorf("KFormat() unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	seen := make(map[Dialect]bool)
+	for _, d := range dialects {
+		if seen[d] {
+			t.Errorf("RegisterDialect() returned dialect %d twice", d)
+		}
+		seen[d] = true
+	}
+}
@@ -0,0 +1,109 @@
+package kurdical
+
+import "time"
+
+// CalendarModel selects how the first day of each Kurdish year is determined.
+type CalendarModel int
+
+const (
+	// Arithmetic is the 33-year break table algorithm used by the Solar Hijri
+	// calendar. It is the default model and is valid for Solar Hijri years
+	// -61 to 3177.
+	Arithmetic CalendarModel = iota
+	// Proleptic continues the 33-year arithmetic cycle of the break table
+	// before its first and after its last break. It agrees with Arithmetic
+	// inside the table and is valid for Solar Hijri years -100000 to 100000.
+	Proleptic
+	// Astronomical starts each year at Newroz as observed at the Tehran
+	// meridian (52.5°E): the day of the March equinox if it happens before
+	// true noon, otherwise the following day. The equinox is computed with
+	// the algorithm of Meeus, which is valid for Gregorian years -1000 to 3000.
+	Astronomical
+	// AstronomicalKurdistan is like Astronomical but observes the noon rule at
+	// 45°E, the UTC+3 meridian used in Erbil and Slemani.
+	AstronomicalKurdistan
+)
+
+// Solar Hijri year range of the Proleptic model, chosen so that the
+// intermediate day numbers stay within the range of g2d and d2g.
+const (
+	prolepticMinYear = -100000
+	prolepticMaxYear = 100000
+)
+
+// Solar Hijri year range of the astronomical models, whose years begin in
+// the Gregorian years covered by the equinox tables.
+const (
+	astronomicalMinYear = equinoxMinYear - 621
+	astronomicalMaxYear = equinoxMaxYear - 621
+)
+
+// ModelDifference records a year in which two calendar models disagree on
+// the Gregorian date of Newroz, the first day of the Kurdish year.
+type ModelDifference struct {
+	Year    int       // Kurdish year
+	NewrozA time.Time // Newroz in the first model
+	NewrozB time.Time // Newroz in the second model
+}
+
+// DiffModels compares the calendar models a and b for the Kurdish years
+// from fromYear to toYear inclusive, and returns the years in which they
+// start on different days.
+func DiffModels(a, b CalendarModel, epoch Epoch, fromYear, toYear int) ([]ModelDifference, error) {
+	offset, ok := epochOffsets[epoch]
+	if !ok {
+		return nil, &ErrorInvalidEpoch{Epoch: epoch}
+	}
+	var diffs []ModelDifference
+	for year := fromYear; year <= toYear; year++ {
+		startA, err := yearStart(year-offset, a)
+		if err != nil {
+			return nil, yearError(err, year)
+		}
+		startB, err := yearStart(year-offset, b)
+		if err != nil {
+			return nil, yearError(err, year)
+		}
+		if startA != startB {
+			diffs = append(diffs, ModelDifference{
+				Year:    year,
+				NewrozA: jdnToTime(startA),
+				NewrozB: jdnToTime(startB),
+			})
+		}
+	}
+	return diffs, nil
+}
+
+// SupportedRange returns the first and last Kurdish year of the given epoch
+// that can be converted with the calendar model.
+func SupportedRange(epoch Epoch, model CalendarModel) (minYear, maxYear int, err error) {
+	offset, ok := epochOffsets[epoch]
+	if !ok {
+		return 0, 0, &ErrorInvalidEpoch{Epoch: epoch}
+	}
+	switch model {
+	case Arithmetic:
+		return breaks[0] + offset, breaks[len(breaks)-1] - 1 + offset, nil
+	case Proleptic:
+		return prolepticMinYear + offset, prolepticMaxYear + offset, nil
+	case Astronomical, AstronomicalKurdistan:
+		return astronomicalMinYear + offset, astronomicalMaxYear + offset, nil
+	}
+	return 0, 0, &ErrorInvalidModel{Model: model}
+}
+
+// yearError reports err, which comes from a Solar Hijri calculation,
+// in terms of the given Kurdish year.
+func yearError(err error, kYear int) error {
+	if _, ok := err.(*ErrorInvalidYear); ok {
+		return &ErrorInvalidYear{Year: kYear}
+	}
+	return err
+}
+
+// jdnToTime returns midnight UTC of the day with the given Julian Day Number.
+func jdnToTime(jdn int) time.Time {
+	gy, gm, gd := d2g(jdn)
+	return time.Date(gy, time.Month(gm), gd, 0, 0, 0, 0, time.UTC)
+}
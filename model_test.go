@@ -0,0 +1,286 @@
+package kurdical
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSupportedRange(t *testing.T) {
+	tests := []struct {
+		epoch   Epoch
+		model   CalendarModel
+		minYear int
+		maxYear int
+	}{
+		{MedianKingdom, Arithmetic, 1260, 4498},
+		{FallOfNineveh, Arithmetic, 1172, 4410},
+		{MedianKingdom, Proleptic, -98679, 101321},
+	}
+
+	for _, tt := range tests {
+		minYear, maxYear, err := SupportedRange(tt.epoch, tt.model)
+		if err != nil {
+			t.Fatalf("SupportedRange() unexpected error: %v", err)
+		}
+		if minYear != tt.minYear || maxYear != tt.maxYear {
+			t.Errorf("SupportedRange(%d, %d) = %d, %d, expected %d, %d",
+				tt.epoch, tt.model, minYear, maxYear, tt.minYear, tt.maxYear)
+		}
+		for _, y := range []int{minYear, maxYear} {
+			if _, _, _, err := KurdishToGregorianDateModel(y, 6, 1, tt.epoch, tt.model); err != nil {
+				t.Errorf("KurdishToGregorianDateModel(%d) unexpected error: %v", y, err)
+			}
+		}
+		for _, y := range []int{minYear - 1, maxYear + 1} {
+			if _, _, _, err := KurdishToGregorianDateModel(y, 6, 1, tt.epoch, tt.model); err == nil {
+				t.Errorf("KurdishToGregorianDateModel(%d) expected error, got none", y)
+			}
+		}
+	}
+
+	if _, _, err := SupportedRange(MedianKingdom, CalendarModel(99)); err == nil {
+		t.Errorf("SupportedRange() expected error for unknown model, got none")
+	}
+}
+
+func TestProlepticMatchesArithmetic(t *testing.T) {
+	for jy := breaks[0]; jy < breaks[len(breaks)-1]; jy++ {
+		a, err := yearStart(jy, Arithmetic)
+		if err != nil {
+			t.Fatalf("yearStart(%d, Arithmetic) unexpected error: %v", jy, err)
+		}
+		p, err := yearStart(jy, Proleptic)
+		if err != nil {
+			t.Fatalf("yearStart(%d, Proleptic) unexpected error: %v", jy, err)
+		}
+		if a != p {
+			t.Errorf("yearStart(%d) = %d (Proleptic), expected %d", jy, p, a)
+		}
+	}
+}
+
+func TestProlepticYearLengths(t *testing.T) {
+	ranges := [][2]int{
+		{prolepticMinYear, prolepticMinYear + 200},
+		{breaks[0] - 200, breaks[0] + 50},
+		{breaks[len(breaks)-1] - 50, breaks[len(breaks)-1] + 200},
+		{prolepticMaxYear - 200, prolepticMaxYear - 1},
+	}
+	for _, r := range ranges {
+		leaps := 0
+		for jy := r[0]; jy < r[1]; jy++ {
+			start, _ := yearStart(jy, Proleptic)
+			next, _ := yearStart(jy+1, Proleptic)
+			switch next - start {
+			case 366:
+				leaps++
+			case 365:
+			default:
+				t.Fatalf("Solar Hijri year %d has %d days", jy, next-start)
+			}
+		}
+		// About 8 leap years in every 33.
+		if expected := (r[1] - r[0]) * 8 / 33; leaps < expected-2 || leaps > expected+2 {
+			t.Errorf("%d leap years in %v, expected about %d", leaps, r, expected)
+		}
+	}
+}
+
+func TestProlepticRoundTrip(t *testing.T) {
+	dates := []time.Time{
+		time.Date(-500, 3, 25, 12, 0, 0, 0, time.UTC),
+		time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(330, 9, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(4000, 3, 20, 0, 0, 0, 0, time.UTC),
+		time.Date(20000, 12, 31, 0, 0, 0, 0, time.UTC),
+	}
+	for _, g := range dates {
+		if _, err := GregorianToKurdishModel(g, Sorani, MedianKingdom, Arithmetic); err == nil {
+			t.Errorf("GregorianToKurdishModel(%v, Arithmetic) expected error, got none", g)
+		}
+		k, err := GregorianToKurdishModel(g, Sorani, MedianKingdom, Proleptic)
+		if err != nil {
+			t.Fatalf("GregorianToKurdishModel(%v, Proleptic) unexpected error: %v", g, err)
+		}
+		if k.Model != Proleptic {
+			t.Errorf("GregorianToKurdishModel() Model = %d, expected Proleptic", k.Model)
+		}
+		result, err := KurdishToGregorian(k)
+		if err != nil {
+			t.Fatalf("KurdishToGregorian(%v) unexpected error: %v", k, err)
+		}
+		if !result.Equal(g) {
+			t.Errorf("KurdishToGregorian(%v) = %v, expected %v", k, result, g)
+		}
+		next, err := k.AddYears(1)
+		if err != nil || next.Model != Proleptic {
+			t.Errorf("AddYears() = %v, %v; expected a Proleptic date", next, err)
+		}
+	}
+}
+
+func TestLeapYearsNearBreaks(t *testing.T) {
+	for _, b := range breaks[1 : len(breaks)-1] {
+		for jy := b - 6; jy <= b+1; jy++ {
+			next, err := yearStart(jy+1, Arithmetic)
+			if err != nil {
+				t.Fatalf("yearStart(%d) unexpected error: %v", jy+1, err)
+			}
+			// The last day of the year must be Resheme 30 exactly in leap years.
+			gy, gm, gd := d2g(next - 1)
+			k, err := GregorianToKurdishDateE(gy, gm, gd, Sorani, MedianKingdom)
+			if err != nil {
+				t.Fatalf("GregorianToKurdishDateE() unexpected error: %v", err)
+			}
+			expected := 29
+			if isSolarHijriLeap(jy, Arithmetic) {
+				expected = 30
+			}
+			if k.Month != 12 || k.Day != expected {
+				t.Errorf("last day of Solar Hijri year %d = %d-%d, expected 12-%d", jy, k.Month, k.Day, expected)
+			}
+			if _, err := KurdishToGregorian(k); err != nil {
+				t.Errorf("KurdishToGregorian(%v) unexpected error: %v", k, err)
+			}
+		}
+	}
+}
+
+func TestLastSupportedYear(t *testing.T) {
+	tests := []struct {
+		model CalendarModel
+		leap  bool
+	}{
+		{Arithmetic, false},
+		{Proleptic, true},
+		{Astronomical, false},
+		{AstronomicalKurdistan, false},
+	}
+
+	for _, tt := range tests {
+		_, maxYear, err := SupportedRange(MedianKingdom, tt.model)
+		if err != nil {
+			t.Fatalf("SupportedRange(%d) unexpected error: %v", tt.model, err)
+		}
+		if leap := isSolarHijriLeap(maxYear-1321, tt.model); leap != tt.leap {
+			t.Errorf("isSolarHijriLeap(%d, %d) = %v, expected %v", maxYear-1321, tt.model, leap, tt.leap)
+		}
+
+		// Resheme 30 exists exactly in a leap year, up to the last supported year.
+		_, _, _, err = KurdishToGregorianDateModel(maxYear, 12, 30, MedianKingdom, tt.model)
+		if (err == nil) != tt.leap {
+			t.Errorf("KurdishToGregorianDateModel(%d-12-30, %d) error = %v, expected leap %v", maxYear, tt.model, err, tt.leap)
+		}
+		k := KurdishDate{Year: maxYear, Month: 12, Day: 29, Dialect: Sorani, Epoch: MedianKingdom, Model: tt.model}
+		expected := ""
+		if tt.leap {
+			expected = "کەبیسە"
+		}
+		if result, _ := k.KFormat("Leap"); result != expected {
+			t.Errorf("KFormat(Leap) for %d in model %d = %q, expected %q", maxYear, tt.model, result, expected)
+		}
+
+		// The last day of the range converts to Gregorian and back, as does
+		// the first day of its Gregorian year.
+		lastDay := 29
+		if tt.leap {
+			lastDay = 30
+		}
+		gy, gm, gd, err := KurdishToGregorianDateModel(maxYear, 12, lastDay, MedianKingdom, tt.model)
+		if err != nil {
+			t.Fatalf("KurdishToGregorianDateModel(%d-12-%d, %d) unexpected error: %v", maxYear, lastDay, tt.model, err)
+		}
+		back, err := GregorianToKurdishDateModel(gy, gm, gd, Sorani, MedianKingdom, tt.model)
+		if err != nil {
+			t.Fatalf("GregorianToKurdishDateModel(%d-%d-%d, %d) unexpected error: %v", gy, gm, gd, tt.model, err)
+		}
+		if back.Year != maxYear || back.Month != 12 || back.Day != lastDay {
+			t.Errorf("GregorianToKurdishDateModel(%d-%d-%d, %d) = %d-%d-%d, expected %d-12-%d", gy, gm, gd, tt.model, back.Year, back.Month, back.Day, maxYear, lastDay)
+		}
+		if _, err := GregorianToKurdishDateModel(gy, 1, 1, Sorani, MedianKingdom, tt.model); err != nil {
+			t.Errorf("GregorianToKurdishDateModel(%d-1-1, %d) unexpected error: %v", gy, tt.model, err)
+		}
+		var yearErr *ErrorInvalidYear
+		if _, err := GregorianToKurdishDateModel(gy, gm, gd+1, Sorani, MedianKingdom, tt.model); !errors.As(err, &yearErr) {
+			t.Errorf("GregorianToKurdishDateModel(%d-%d-%d, %d) error = %v, expected ErrorInvalidYear", gy, gm, gd+1, tt.model, err)
+		}
+	}
+
+	// The arithmetic year before the last break agrees with the leap flag
+	// of jalCal, which is 0 in leap years.
+	leap, _, _, _ := jalCal(breaks[len(breaks)-1] - 1)
+	if isSolarHijriLeap(breaks[len(breaks)-1]-1, Arithmetic) != (leap == 0) {
+		t.Errorf("isSolarHijriLeap(%d) disagrees with jalCal", breaks[len(breaks)-1]-1)
+	}
+}
+
+func TestAstronomicalMatchesArithmetic(t *testing.T) {
+	// The break table was designed to follow the astronomical calendar at the
+	// Tehran meridian, so the two agree in modern times.
+	diffs, err := DiffModels(Arithmetic, Astronomical, MedianKingdom, 2500, 2800)
+	if err != nil {
+		t.Fatalf("DiffModels() unexpected error: %v", err)
+	}
+	if len(diffs) != 0 {
+		t.Errorf("DiffModels() = %v, expected no differences", diffs)
+	}
+}
+
+func TestAstronomicalKurdistan(t *testing.T) {
+	diffs, err := DiffModels(Arithmetic, AstronomicalKurdistan, MedianKingdom, 2700, 2730)
+	if err != nil {
+		t.Fatalf("DiffModels() unexpected error: %v", err)
+	}
+	// The 2025 equinox at 09:01 UTC is after true noon in Tehran
+	// but before true noon at 45°E.
+	expected := []ModelDifference{{
+		Year:    2725,
+		NewrozA: time.Date(2025, 3, 21, 0, 0, 0, 0, time.UTC),
+		NewrozB: time.Date(2025, 3, 20, 0, 0, 0, 0, time.UTC),
+	}}
+	if len(diffs) != len(expected) || diffs[0] != expected[0] {
+		t.Fatalf("DiffModels() = %v, expected %v", diffs, expected)
+	}
+
+	k, err := GregorianToKurdishModel(time.Date(2025, 3, 20, 0, 0, 0, 0, time.UTC), Sorani, MedianKingdom, AstronomicalKurdistan)
+	if err != nil {
+		t.Fatalf("GregorianToKurdishModel() unexpected error: %v", err)
+	}
+	if k.Year != 2725 || k.Month != 1 || k.Day != 1 {
+		t.Errorf("GregorianToKurdishModel() = %d-%d-%d, expected 2725-1-1", k.Year, k.Month, k.Day)
+	}
+	prev, err := k.AddDays(-1)
+	if err != nil {
+		t.Fatalf("AddDays() unexpected error: %v", err)
+	}
+	if prev.Year != 2724 || prev.Month != 12 || prev.Day != 29 {
+		t.Errorf("AddDays(-1) = %d-%d-%d, expected 2724-12-29", prev.Year, prev.Month, prev.Day)
+	}
+}
+
+func TestAstronomicalRange(t *testing.T) {
+	minYear, maxYear, err := SupportedRange(MedianKingdom, Astronomical)
+	if err != nil {
+		t.Fatalf("SupportedRange() unexpected error: %v", err)
+	}
+	if minYear != -300 || maxYear != 3700 {
+		t.Errorf("SupportedRange() = %d, %d, expected -300, 3700", minYear, maxYear)
+	}
+	if _, err := DiffModels(Arithmetic, Astronomical, MedianKingdom, 3690, 3710); err == nil {
+		t.Errorf("DiffModels() expected error beyond the supported range, got none")
+	}
+	if _, _, _, err := KurdishToGregorianDateModel(minYear, 1, 1, MedianKingdom, Astronomical); err != nil {
+		t.Errorf("KurdishToGregorianDateModel(%d) unexpected error: %v", minYear, err)
+	}
+	for _, y := range []int{2000, 2723, maxYear} {
+		_, gm, gd, err := KurdishToGregorianDateModel(y, 1, 1, MedianKingdom, Astronomical)
+		if err != nil {
+			t.Fatalf("KurdishToGregorianDateModel(%d) unexpected error: %v", y, err)
+		}
+		// In the Gregorian calendar Newroz stays close to 21 March.
+		if gm != 3 || gd < 19 || gd > 22 {
+			t.Errorf("Newroz of %d is %d-%d, expected around 21 March", y, gm, gd)
+		}
+	}
+}
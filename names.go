@@ -0,0 +1,115 @@
+package kurdical
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// epochNames holds the names returned by Epoch.String.
+var epochNames = map[Epoch]string{
+	MedianKingdom: "MedianKingdom",
+	FallOfNineveh: "FallOfNineveh",
+}
+
+// Name returns the name of the month in the given dialect and script.
+// A dialect that has no names in the script gives the name in its own
+// script. It returns "" for an unknown month or dialect.
+func (m Month) Name(dialect Dialect, script Script) string {
+	loc, ok := lookupLocale(dialect)
+	if !ok || m < Khakelive || m > Resheme {
+		return ""
+	}
+	n, _ := loc.namesIn(script)
+	return n.months[m-1]
+}
+
+// String returns the Sorani name of the month in Latin script, such as
+// "Xakelêwe".
+func (m Month) String() string {
+	if name := m.Name(Sorani, LatinScript); name != "" {
+		return name
+	}
+	return fmt.Sprintf("Month(%d)", int(m))
+}
+
+// String returns the name of the dialect, such as "Sorani".
+func (d Dialect) String() string {
+	if loc, ok := lookupLocale(d); ok {
+		return loc.name
+	}
+	return fmt.Sprintf("Dialect(%d)", int(d))
+}
+
+// String returns the name of the epoch, such as "MedianKingdom".
+func (e Epoch) String() string {
+	if name, ok := epochNames[e]; ok {
+		return name
+	}
+	return fmt.Sprintf("Epoch(%d)", int(e))
+}
+
+// ParseDialect returns the dialect with the given name, built-in or
+// registered, ignoring case.
+func ParseDialect(name string) (Dialect, error) {
+	localesMu.RLock()
+	d, loc := findLocale(strings.TrimSpace(name))
+	localesMu.RUnlock()
+	if loc == nil {
+		return 0, &ErrorParse{Value: name, Message: ": unknown dialect"}
+	}
+	return d, nil
+}
+
+// ParseEpoch returns the epoch with the given name, ignoring case and
+// spaces, so that "MedianKingdom" and "median kingdom" are both accepted.
+func ParseEpoch(name string) (Epoch, error) {
+	key := strings.ReplaceAll(name, " ", "")
+	for e, s := range epochNames {
+		if strings.EqualFold(s, key) {
+			return e, nil
+		}
+	}
+	return 0, &ErrorParse{Value: name, Message: ": unknown epoch"}
+}
+
+// ParseMonthName returns the month named by name in any dialect and
+// script, and the dialect whose spelling matched. Arabic-script names are
+// compared after NormalizeKurdish, ignoring zero width non-joiners, and
+// Latin-script names ignoring case. If several dialects spell the month
+// the same way, the dialect with the lowest value is reported, so
+// built-in dialects are preferred to registered ones.
+func ParseMonthName(name string) (Month, Dialect, error) {
+	key := nameKey(name)
+	if key != "" {
+		localesMu.RLock()
+		dialects := make([]Dialect, 0, len(locales))
+		for d := range locales {
+			dialects = append(dialects, d)
+		}
+		localesMu.RUnlock()
+		sort.Slice(dialects, func(i, j int) bool { return dialects[i] < dialects[j] })
+
+		for _, d := range dialects {
+			loc, _ := lookupLocale(d)
+			for _, n := range loc.scripts {
+				if n == nil {
+					continue
+				}
+				for i, month := range n.months {
+					if nameKey(month) == key {
+						return Month(i + 1), d, nil
+					}
+				}
+			}
+		}
+	}
+	return 0, 0, &ErrorParse{Value: name, Message: ": unknown month name"}
+}
+
+// nameKey returns the form of a name used to compare names regardless of
+// orthography and case.
+func nameKey(name string) string {
+	name = strings.ReplaceAll(NormalizeKurdish(strings.TrimSpace(name)), string(zwnj), "")
+	return strings.ToLower(name)
+}
@@ -0,0 +1,125 @@
+package kurdical
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestMonthName(t *testing.T) {
+	tests := []struct {
+		month    Month
+		dialect  Dialect
+		script   Script
+		expected string
+	}{
+		{Khakelive, Sorani, ArabicScript, "خاکه‌لێوه"},
+		{Khakelive, Kurmanji, LatinScript, "Nîsan"},
+		{Resheme, Hawrami, LatinScript, "Siyawkam"},
+		{Gelavizh, Laki, ArabicScript, "مردار"},
+		{Month(0), Sorani, ArabicScript, ""},
+		{Month(13), Sorani, ArabicScript, ""},
+		{Gulan, Dialect(99), ArabicScript, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			result := tt.month.Name(tt.dialect, tt.script)
+			if result != tt.expected {
+				t.Errorf("Month(%d).Name(%d, %d) = %q, expected %q", tt.month, tt.dialect, tt.script, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStringers(t *testing.T) {
+	tests := []struct {
+		value    fmt.Stringer
+		expected string
+	}{
+		{Khakelive, "Xakelêwe"},
+		{Resheme, "Reşeme"},
+		{Month(13), "Month(13)"},
+		{Sorani, "Sorani"},
+		{Kurmanji, "Kurmanji"},
+		{Dialect(99), "Dialect(99)"},
+		{MedianKingdom, "MedianKingdom"},
+		{FallOfNineveh, "FallOfNineveh"},
+		{Epoch(7), "Epoch(7)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if result := tt.value.String(); result != tt.expected {
+				t.Errorf("String() = %q, expected %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseDialectAndEpoch(t *testing.T) {
+	for _, d := range []Dialect{Laki, Hawrami, Sorani, Kalhuri, Kurmanji} {
+		parsed, err := ParseDialect(d.String())
+		if err != nil || parsed != d {
+			t.Errorf("ParseDialect(%q) = %d, %v, expected %d", d.String(), parsed, err, d)
+		}
+	}
+	if d, err := ParseDialect(" kurmanji "); err != nil || d != Kurmanji {
+		t.Errorf("ParseDialect() = %d, %v, expected Kurmanji", d, err)
+	}
+	var parseErr *ErrorParse
+	if _, err := ParseDialect("Klingon"); !errors.As(err, &parseErr) {
+		t.Errorf("ParseDialect() error = %v, expected ErrorParse", err)
+	}
+
+	for _, e := range []Epoch{MedianKingdom, FallOfNineveh} {
+		parsed, err := ParseEpoch(e.String())
+		if err != nil || parsed != e {
+			t.Errorf("ParseEpoch(%q) = %d, %v, expected %d", e.String(), parsed, err, e)
+		}
+	}
+	if e, err := ParseEpoch("fall of nineveh"); err != nil || e != FallOfNineveh {
+		t.Errorf("ParseEpoch() = %d, %v, expected FallOfNineveh", e, err)
+	}
+	if _, err := ParseEpoch("Seleucid"); !errors.As(err, &parseErr) {
+		t.Errorf("ParseEpoch() error = %v, expected ErrorParse", err)
+	}
+}
+
+func TestParseMonthName(t *testing.T) {
+	tests := []struct {
+		name    string
+		month   Month
+		dialect Dialect
+	}{
+		{"خاکه‌لێوه", Khakelive, Sorani},
+		{"خاکەلێوە", Khakelive, Sorani},
+		{"نیسان", Khakelive, Kurmanji},
+		{"nîsan", Khakelive, Kurmanji},
+		{"Gulan", Gulan, Sorani},
+		{"گوڵان", Gulan, Sorani},
+		{"سیاوکام", Resheme, Hawrami},
+		{"مانگ لیه", Resheme, Laki},
+		{" Befran ", Befranbar, Kalhuri},
+		{"رێبه‌ندان", Ribendan, Sorani},
+		{"ره‌شه‌مێ", Resheme, Sorani},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			month, dialect, err := ParseMonthName(tt.name)
+			if err != nil {
+				t.Fatalf("ParseMonthName(%q) unexpected error: %v", tt.name, err)
+			}
+			if month != tt.month || dialect != tt.dialect {
+				t.Errorf("ParseMonthName(%q) = %v, %v, expected %v, %v", tt.name, month, dialect, tt.month, tt.dialect)
+			}
+		})
+	}
+
+	for _, name := range []string{"", "January", "خاک"} {
+		if _, _, err := ParseMonthName(name); err == nil {
+			t.Errorf("ParseMonthName(%q) expected error, got none", name)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package kurdical
+
+import (
+	"math"
+	"time"
+)
+
+// unixEpochJD is the Julian Date of 1970-01-01 00:00 UTC.
+const unixEpochJD = 2440587.5
+
+// NewrozMoment returns the instant of the March equinox that begins the
+// given Kurdish year, rounded to the minute and expressed in loc.
+// A nil loc means UTC.
+//
+// The equinox is computed with the algorithm of Meeus, so the year must be
+// within SupportedRange(epoch, Astronomical). NewrozMoment returns the zero
+// Time for a year outside that range or an unknown epoch.
+func NewrozMoment(kurdishYear int, epoch Epoch, loc *time.Location) time.Time {
+	offset, ok := epochOffsets[epoch]
+	if !ok {
+		return time.Time{}
+	}
+	gy := kurdishYear - offset + 621
+	if gy < equinoxMinYear || gy > equinoxMaxYear {
+		return time.Time{}
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	return equinoxTime(gy).Round(time.Minute).In(loc)
+}
+
+// NextNewroz returns the Kurdish year, in the given epoch, that begins at
+// the first March equinox after t, and the moment it begins in t's location.
+// It returns the zero Time if that year is outside the supported range.
+func NextNewroz(t time.Time, epoch Epoch) (kurdishYear int, moment time.Time) {
+	offset, ok := epochOffsets[epoch]
+	if !ok {
+		return 0, time.Time{}
+	}
+	gy := t.UTC().Year()
+	if gy >= equinoxMinYear && gy <= equinoxMaxYear {
+		if m := equinoxTime(gy).Round(time.Minute); m.After(t) {
+			return gy - 621 + offset, m.In(t.Location())
+		}
+	}
+	gy++
+	if gy < equinoxMinYear || gy > equinoxMaxYear {
+		return 0, time.Time{}
+	}
+	return gy - 621 + offset, equinoxTime(gy).Round(time.Minute).In(t.Location())
+}
+
+// UntilNewroz returns the time left from t until the next Newroz,
+// for example to display a countdown to the New Year. It returns 0 if the
+// next Newroz is outside the supported range.
+func UntilNewroz(t time.Time) time.Duration {
+	_, moment := NextNewroz(t, MedianKingdom)
+	if moment.IsZero() {
+		return 0
+	}
+	return moment.Sub(t)
+}
+
+// equinoxTime returns the instant of the March equinox of a Gregorian year.
+func equinoxTime(gy int) time.Time {
+	ut := marchEquinox(gy) - deltaT(gy)/86400
+	sec, frac := math.Modf((ut - unixEpochJD) * 86400)
+	return time.Unix(int64(sec), int64(frac*1e9)).UTC()
+}
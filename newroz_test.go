@@ -0,0 +1,71 @@
+package kurdical
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewrozMoment(t *testing.T) {
+	tests := []struct {
+		year     int
+		epoch    Epoch
+		expected time.Time
+	}{
+		{2723, MedianKingdom, time.Date(2023, 3, 20, 21, 24, 24, 0, time.UTC)},
+		{2724, MedianKingdom, time.Date(2024, 3, 20, 3, 6, 21, 0, time.UTC)},
+		{2725, MedianKingdom, time.Date(2025, 3, 20, 9, 1, 25, 0, time.UTC)},
+		{2638, FallOfNineveh, time.Date(2026, 3, 20, 14, 46, 0, 0, time.UTC)},
+		{2700, MedianKingdom, time.Date(2000, 3, 20, 7, 35, 15, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		result := NewrozMoment(tt.year, tt.epoch, time.UTC)
+		if d := result.Sub(tt.expected); d < -time.Minute || d > time.Minute {
+			t.Errorf("NewrozMoment(%d) = %v, expected %v", tt.year, result, tt.expected)
+		}
+		if result.Second() != 0 || result.Nanosecond() != 0 {
+			t.Errorf("NewrozMoment(%d) = %v is not rounded to the minute", tt.year, result)
+		}
+	}
+
+	tehran := time.FixedZone("IRST", 3*3600+1800)
+	if m := NewrozMoment(2725, MedianKingdom, tehran); m.Location() != tehran || m.Hour() != 12 {
+		t.Errorf("NewrozMoment() in Tehran = %v, expected about 12:31 IRST", m)
+	}
+	if m := NewrozMoment(2725, MedianKingdom, nil); m.Location() != time.UTC {
+		t.Errorf("NewrozMoment() with nil location = %v, expected UTC", m)
+	}
+	if m := NewrozMoment(9999, MedianKingdom, time.UTC); !m.IsZero() {
+		t.Errorf("NewrozMoment(9999) = %v, expected zero time", m)
+	}
+	if m := NewrozMoment(2725, Epoch(5), time.UTC); !m.IsZero() {
+		t.Errorf("NewrozMoment() with unknown epoch = %v, expected zero time", m)
+	}
+}
+
+func TestNextNewroz(t *testing.T) {
+	moment := NewrozMoment(2725, MedianKingdom, time.UTC)
+	tests := []struct {
+		name     string
+		now      time.Time
+		year     int
+		expected time.Time
+	}{
+		{"Earlier in the year", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), 2725, moment},
+		{"A minute before", moment.Add(-time.Minute), 2725, moment},
+		{"At the moment", moment, 2726, NewrozMoment(2726, MedianKingdom, time.UTC)},
+		{"Later in the year", time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC), 2726, NewrozMoment(2726, MedianKingdom, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			year, next := NextNewroz(tt.now, MedianKingdom)
+			if year != tt.year || !next.Equal(tt.expected) {
+				t.Errorf("NextNewroz() = %d, %v, expected %d, %v", year, next, tt.year, tt.expected)
+			}
+			if d := UntilNewroz(tt.now); d != tt.expected.Sub(tt.now) {
+				t.Errorf("UntilNewroz() = %v, expected %v", d, tt.expected.Sub(tt.now))
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+package kurdical
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Orthography selects how the Kurdish vowel ە is spelled in names written
+// in Arabic script.
+type Orthography int
+
+const (
+	// DefaultOrthography writes names as they are spelled in the locale data.
+	DefaultOrthography Orthography = iota
+	// StandardOrthography writes ە as ARABIC LETTER AE (U+06D5), as in
+	// "خاکەلێوە".
+	StandardOrthography
+	// LegacyOrthography writes ە as ARABIC LETTER HEH (U+0647), followed
+	// by a ZERO WIDTH NON-JOINER (U+200C) inside a word, as in "خاکه‌لێوه".
+	LegacyOrthography
+)
+
+const (
+	heh  = '\u0647' // ه
+	ae   = '\u06d5' // ە
+	zwnj = '\u200c'
+)
+
+// NormalizeKurdish returns s in standard Kurdish orthography, for comparing
+// and searching Kurdish text regardless of how it was typed:
+//
+//   - heh followed by a zero width non-joiner becomes ە (U+06D5);
+//   - heh at the end of a word becomes ە if the names of a registered
+//     dialect show that the word ends in that vowel, as in "خاکه‌لێوه";
+//     a final heh that is the consonant h, as in the Kurmanji "جوتمه"
+//     (Cotmeh), is kept;
+//   - Arabic kaf (ك) becomes keheh (ک);
+//   - Arabic yeh (ي) and alef maksura (ى) become Farsi yeh (ی).
+//
+// Text that is not in Arabic script is returned unchanged.
+func NormalizeKurdish(s string) string {
+	s = strings.Map(func(r rune) rune {
+		switch r {
+		case '\u0643': // ك
+			return '\u06a9' // ک
+		case '\u064a', '\u0649': // ي ى
+			return '\u06cc' // ی
+		}
+		return r
+	}, s)
+	return toStandard(s, isVowelWord)
+}
+
+// isVowelWord reports whether the names of any registered dialect show
+// that word, as written by toStandard up to its final heh, ends in the
+// vowel ە.
+func isVowelWord(word string) bool {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+	for _, loc := range locales {
+		if n := loc.scripts[ArabicScript]; n != nil && n.vowels[word] {
+			return true
+		}
+	}
+	return false
+}
+
+// spell returns s written in orthography o. vowel reports whether a word
+// that ends in heh ends in the vowel ە, as for toStandard.
+func spell(s string, o Orthography, vowel func(word string) bool) string {
+	switch o {
+	case StandardOrthography:
+		return toStandard(s, vowel)
+	case LegacyOrthography:
+		return toLegacy(s)
+	}
+	return s
+}
+
+// toStandard replaces the legacy spellings of ە in s: heh followed by a
+// zero width non-joiner, and heh at the end of a word for which vowel,
+// if not nil, reports true. vowel is given the word as written in s up to
+// its final heh, with the earlier spellings of ە already replaced. A heh
+// that begins a word is the consonant h and is kept.
+func toStandard(s string, vowel func(word string) bool) string {
+	if !strings.ContainsRune(s, heh) {
+		return s
+	}
+	rs := []rune(s)
+	b := make([]rune, 0, len(rs))
+	start := 0 // start of the current word in b
+	for i := 0; i < len(rs); i++ {
+		r := rs[i]
+		if r == heh && i > 0 && isArabicLetter(rs[i-1]) {
+			switch {
+			case i+1 < len(rs) && rs[i+1] == zwnj:
+				r = ae
+				i++
+			case (i+1 == len(rs) || !isArabicLetter(rs[i+1])) && vowel != nil && vowel(string(b[start:])+string(heh)):
+				r = ae
+			}
+		}
+		b = append(b, r)
+		if r != zwnj && !isArabicLetter(r) {
+			start = len(b)
+		}
+	}
+	return string(b)
+}
+
+// toLegacy replaces ە in s with heh, followed by a zero width non-joiner
+// when the word continues.
+func toLegacy(s string) string {
+	if !strings.ContainsRune(s, ae) {
+		return s
+	}
+	rs := []rune(s)
+	b := make([]rune, 0, len(rs)+4)
+	for i, r := range rs {
+		if r != ae {
+			b = append(b, r)
+			continue
+		}
+		b = append(b, heh)
+		if i+1 < len(rs) && isArabicLetter(rs[i+1]) {
+			b = append(b, zwnj)
+		}
+	}
+	return string(b)
+}
+
+// findVowelWords returns the words of the Arabic-script names in arabic
+// whose final ە may be written as heh, keyed as toStandard looks them up.
+// A word is included if it is written with a final ە, or if it ends in
+// heh and the same word of the name in latin, if given, ends in "e".
+// Abbreviations are not compared with their Latin forms, which are cut
+// short, but use the words found in the full names.
+func findVowelWords(arabic, latin *names) map[string]bool {
+	words := make(map[string]bool)
+	add := func(name, latinName string) {
+		aw, lw := strings.Fields(name), strings.Fields(latinName)
+		for i, w := range aw {
+			w = strings.TrimFunc(w, func(r rune) bool { return r != zwnj && !isArabicLetter(r) })
+			last, size := utf8.DecodeLastRuneInString(w)
+			if len(w) == size {
+				continue
+			}
+			switch {
+			case last == ae:
+				words[toStandard(w[:len(w)-size], nil)+string(heh)] = true
+			case last == heh && len(aw) == len(lw) && endsInE(lw[i]):
+				words[toStandard(w, nil)] = true
+			}
+		}
+	}
+	tables := func(tab, latinTab []string) {
+		for i, name := range tab {
+			latinName := ""
+			if len(latinTab) == len(tab) {
+				latinName = latinTab[i]
+			}
+			add(name, latinName)
+		}
+	}
+	var l names
+	if latin != nil {
+		l = *latin
+	}
+	tables(arabic.months, l.months)
+	tables(arabic.weekdays, l.weekdays)
+	tables(arabic.gregorianMonths, l.gregorianMonths)
+	tables(arabic.ordinals, l.ordinals)
+	tables(arabic.seasons, l.seasons)
+	tables(arabic.shortMonths, nil)
+	tables(arabic.shortWeekdays, nil)
+	tables(arabic.shortGregorianMonths, nil)
+	tables([]string{arabic.am, arabic.pm, arabic.yearPrefix, arabic.era, arabic.gregorianEra, arabic.leapYear},
+		[]string{l.am, l.pm, l.yearPrefix, l.era, l.gregorianEra, l.leapYear})
+	tables([]string{arabic.shortEra, arabic.shortGregorianEra}, nil)
+	return words
+}
+
+// endsInE reports whether a Latin-script word ends in the vowel e,
+// ignoring punctuation.
+func endsInE(w string) bool {
+	w = strings.TrimFunc(w, func(r rune) bool { return !unicode.IsLetter(r) })
+	return strings.HasSuffix(w, "e") || strings.HasSuffix(w, "E")
+}
+
+// isArabicLetter reports whether r is a letter of the Arabic script.
+func isArabicLetter(r rune) bool {
+	return unicode.Is(unicode.Arabic, r) && unicode.IsLetter(r)
+}
+
+// spelled returns a copy of n with every name written in orthography o.
+func (n *names) spelled(o Orthography) *names {
+	one := func(s string) string {
+		return spell(s, o, n.isVowelWord)
+	}
+	all := func(tab []string) []string {
+		if tab == nil {
+			return nil
+		}
+		out := make([]string, len(tab))
+		for i, s := range tab {
+			out[i] = one(s)
+		}
+		return out
+	}
+	finals := make(map[string]bool, len(n.finals))
+	for name, v := range n.finals {
+		finals[one(name)] = v
+	}
+	return &names{
+		script:        n.script,
+		orthography:   o,
+		vowels:        n.vowels,
+		finals:        finals,
+		months:        all(n.months),
+		shortMonths:   all(n.shortMonths),
+		weekdays:      all(n.weekdays),
+		shortWeekdays: all(n.shortWeekdays),
+		am:            one(n.am),
+		pm:            one(n.pm),
+
+		gregorianMonths:      all(n.gregorianMonths),
+		shortGregorianMonths: all(n.shortGregorianMonths),
+
+		ordinals:        all(n.ordinals),
+		ezafe:           one(n.ezafe),
+		ezafeAfterVowel: one(n.ezafeAfterVowel),
+		yearPrefix:      one(n.yearPrefix),
+
+		seasons:           all(n.seasons),
+		era:               one(n.era),
+		shortEra:          one(n.shortEra),
+		gregorianEra:      one(n.gregorianEra),
+		shortGregorianEra: one(n.shortGregorianEra),
+		leapYear:          one(n.leapYear),
+
+		periodUnits:     [3]string{one(n.periodUnits[0]), one(n.periodUnits[1]), one(n.periodUnits[2])},
+		periodSeparator: one(n.periodSeparator),
+	}
+}
+
+// isVowelWord is like the function isVowelWord but looks only at the
+// names in n.
+func (n *names) isVowelWord(word string) bool {
+	return n.vowels[word]
+}
+
+// spellNames prepares the names of l in every orthography. It must be
+// called once all scripts of l are set.
+func (l *locale) spellNames() {
+	latin := l.scripts[LatinScript]
+	if arabic := l.scripts[ArabicScript]; arabic != nil {
+		arabic.vowels = findVowelWords(arabic, latin)
+		arabic.finals = findVowelFinals(arabic, latin)
+	}
+	if latin != nil {
+		latin.finals = findVowelFinals(latin, nil)
+	}
+	for _, n := range l.scripts {
+		if n != nil {
+			n.standard = n.spelled(StandardOrthography)
+			n.legacy = n.spelled(LegacyOrthography)
+		}
+	}
+}
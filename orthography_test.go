@@ -0,0 +1,125 @@
+package kurdical
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNormalizeKurdish(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"Heh and ZWNJ", "خاکه‌لێوه", "خاکەلێوە"},
+		{"Already standard", "خاکەلێوە", "خاکەلێوە"},
+		{"Initial heh", "هه‌ینی", "هەینی"},
+		{"Lone heh", "ه", "ه"},
+		{"Heh before space", "ماله‌ژیر دوماینه", "مالەژیر دوماینە"},
+		{"Arabic kaf and yeh", "يەك شەممە", "یەک شەممە"},
+		{"Alef maksura", "ى", "ی"},
+		{"Medial heh", "مەهاباد", "مەهاباد"},
+		{"Latin", "Xakelêwe", "Xakelêwe"},
+		{"Consonant h", "جوتمه", "جوتمه"},
+		{"Consonant h after ە", "تیرمەه", "تیرمەه"},
+		{"Vowel from Latin name", "چله", "چلە"},
+		{"Word not in any dialect", "نامه", "نامه"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NormalizeKurdish(tt.input)
+			if result != tt.expected {
+				t.Errorf("NormalizeKurdish(%q) = %q, expected %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestToLegacy(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"خاکەلێوە", "خاکه‌لێوه"},
+		{"گەلاویژ", "گه‌لاویژ"},
+		{"مانگ سیە", "مانگ سیه"},
+		{"خاکه‌لێوه", "خاکه‌لێوه"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			result := toLegacy(tt.input)
+			if result != tt.expected {
+				t.Errorf("toLegacy(%q) = %q, expected %q", tt.input, result, tt.expected)
+			}
+			if NormalizeKurdish(result) != toStandard(tt.input, isVowelWord) {
+				t.Errorf("NormalizeKurdish(%q) = %q, expected %q", result, NormalizeKurdish(result), toStandard(tt.input, isVowelWord))
+			}
+		})
+	}
+}
+
+func TestKFormatOrthography(t *testing.T) {
+	k := GregorianToKurdish(time.Date(2023, 3, 26, 15, 4, 0, 0, time.UTC), Sorani, MedianKingdom)
+	const layout = "Monday 2 January 2006 PM"
+	tests := []struct {
+		orthography Orthography
+		expected    string
+	}{
+		{DefaultOrthography, "یەک‌شەممە ٦ خاکه‌لێوه ٢٧٢٣ دوای نیوەڕۆ"},
+		{StandardOrthography, "یەک‌شەممە ٦ خاکەلێوە ٢٧٢٣ دوای نیوەڕۆ"},
+		{LegacyOrthography, "یه‌ک‌شه‌ممه ٦ خاکه‌لێوه ٢٧٢٣ دوای نیوه‌ڕۆ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			opts := FormatOptions{Orthography: tt.orthography}
+			result, err := k.KFormatWith(layout, opts)
+			if err != nil {
+				t.Fatalf("KFormatWith() unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("KFormatWith() = %q, expected %q", result, tt.expected)
+			}
+
+			parsed, err := KParseWith(layout, result, Sorani, MedianKingdom, opts)
+			if err != nil {
+				t.Fatalf("KParseWith() unexpected error: %v", err)
+			}
+			if parsed.DayKey() != k.DayKey() || parsed.Hour != 12 {
+				t.Errorf("KParseWith() = %v, expected %v", parsed, k)
+			}
+		})
+	}
+}
+
+func TestKFormatOrthographyConsonant(t *testing.T) {
+	// 2723/7/1; the Kurmanji month جوتمه is Cotmeh, whose final heh is h.
+	k := GregorianToKurdish(time.Date(2023, 9, 23, 0, 0, 0, 0, time.UTC), Kurmanji, MedianKingdom)
+	for _, o := range []Orthography{DefaultOrthography, StandardOrthography, LegacyOrthography} {
+		result, err := k.KFormatWith("January", FormatOptions{Orthography: o})
+		if err != nil {
+			t.Fatalf("KFormatWith() unexpected error: %v", err)
+		}
+		if result != "جوتمه" {
+			t.Errorf("KFormatWith(Orthography %d) = %q, expected %q", o, result, "جوتمه")
+		}
+	}
+}
+
+func TestOrthographyNames(t *testing.T) {
+	for d := range builtinDialects {
+		loc, _ := lookupLocale(d)
+		n := loc.scripts[ArabicScript]
+		for _, name := range append(append([]string(nil), n.months...), n.weekdays[1:]...) {
+			if s := spell(name, StandardOrthography, n.isVowelWord); strings.Contains(s, "ه‌") {
+				t.Errorf("standard spelling of %q = %q still has heh and ZWNJ", name, s)
+			}
+			if s := spell(name, LegacyOrthography, n.isVowelWord); strings.ContainsRune(s, 'ە') {
+				t.Errorf("legacy spelling of %q = %q still has ە", name, s)
+			}
+		}
+	}
+}
@@ -0,0 +1,391 @@
+package kurdical
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// KParse parses a string formatted with the given Golang layout and returns
+// the Kurdish date it represents. It is the inverse of KFormat: numbers are
+// read as Kurdish digits and month and weekday names are matched against
+// the names of the given dialect. The parsed date is validated with the same
+// rules as KurdishToGregorianDate.
+//
+// The time of day is read from the clock tokens, if any, and the result has
+// a nil (UTC) Location. A weekday name in the value is checked to be a valid
+// name but is otherwise ignored. A week, quarter or season must agree with
+// the date, and a quarter or season without a month gives its first month.
+// The era must be the Kurdish era of the dialect. Two-digit years ("06") are taken to be in
+// the century that contains Solar Hijri year 1400 of the epoch. Quoted
+// text in the layout, described at KFormat, must appear in the value as
+// it is written, and a quote that is not closed is an error.
+func KParse(layout, value string, dialect Dialect, epoch Epoch) (KurdishDate, error) {
+	return KParseWith(layout, value, dialect, epoch, FormatOptions{})
+}
+
+// KParseWith is like KParse but reads the value as written by KFormatWith
+// with the same options.
+func KParseWith(layout, value string, dialect Dialect, epoch Epoch, opts FormatOptions) (KurdishDate, error) {
+	alayout, avalue := layout, value
+	loc, ok := lookupLocale(dialect)
+	if !ok {
+		return KurdishDate{}, &ErrorInvalidDialect{Dialect: dialect}
+	}
+	names, zero := loc.resolve(opts)
+	if _, ok := epochOffsets[epoch]; !ok {
+		return KurdishDate{}, &ErrorInvalidEpoch{Epoch: epoch}
+	}
+	if err := checkLayout(layout); err != nil {
+		return KurdishDate{}, err
+	}
+
+	var (
+		year       int
+		month      = -1
+		day        = -1
+		yday       = -1
+		week       = -1
+		quarter    = -1
+		season     = -1
+		hour       int
+		min        int
+		sec        int
+		nsec       int
+		pmSet      bool
+		amSet      bool
+		rangeErrOK bool
+	)
+
+	// Each iteration processes one std value.
+	for {
+		var err error
+		prefix, std, suffix := nextStdChunk(layout)
+		stdstr := layout[len(prefix) : len(layout)-len(suffix)]
+		literal := prefix
+		if names.script == LatinScript {
+			literal = strings.ReplaceAll(literal, arabicComma, ",")
+		}
+		value, err = skip(value, literal)
+		if err != nil {
+			return KurdishDate{}, &ErrorParse{Layout: alayout, Value: avalue, LayoutElem: prefix, ValueElem: value}
+		}
+		if std == 0 {
+			if len(value) != 0 {
+				return KurdishDate{}, &ErrorParse{Layout: alayout, Value: avalue, Message: ": extra text: " + value}
+			}
+			break
+		}
+		layout = suffix
+
+		hold := value
+		switch std & stdMask {
+		case stdYear:
+			var yy int
+			yy, value, err = getnum(value, true, zero)
+			if err == nil {
+				year = yy + (epochOffsets[epoch]+1400)/100*100
+			}
+		case stdLongYear:
+			year, value, err = getnumN(value, 4, zero)
+		case stdMonth, stdLongMonth:
+			tab := names.months
+			if std == stdMonth {
+				tab = names.shortMonths
+			}
+			month, value, err = lookup(tab, value)
+			month++
+		case stdNumMonth, stdZeroMonth:
+			month, value, err = getnum(value, std == stdZeroMonth, zero)
+			if err == nil && (month <= 0 || 12 < month) {
+				return KurdishDate{}, &ErrorInvalidMonth{Month: month}
+			}
+		case stdWeekDay, stdLongWeekDay:
+			tab := names.weekdays
+			if std == stdWeekDay {
+				tab = names.shortWeekdays
+			}
+			_, value, err = lookup(tab[1:], value)
+		case stdDay, stdUnderDay, stdZeroDay:
+			if std == stdUnderDay && len(value) > 0 && value[0] == ' ' {
+				value = value[1:]
+			}
+			day, value, err = getnum(value, std == stdZeroDay, zero)
+			// Day range is validated against the month length below.
+		case stdHour:
+			hour, value, err = getnum(value, false, zero)
+			rangeErrOK = hour < 0 || 24 <= hour
+		case stdHour12, stdZeroHour12:
+			hour, value, err = getnum(value, std == stdZeroHour12, zero)
+			rangeErrOK = hour < 0 || 12 < hour
+		case stdMinute, stdZeroMinute:
+			min, value, err = getnum(value, std == stdZeroMinute, zero)
+			rangeErrOK = min < 0 || 60 <= min
+		case stdSecond, stdZeroSecond:
+			sec, value, err = getnum(value, std == stdZeroSecond, zero)
+			rangeErrOK = sec < 0 || 60 <= sec
+		case stdPM, stdpm:
+			var i int
+			i, value, err = lookup([]string{names.am, names.pm}, value)
+			amSet, pmSet = i == 0, i == 1
+		case stdOrdinalDay:
+			if len(names.ordinals) == 0 {
+				day, value, err = getnum(value, false, zero)
+				break
+			}
+			day, value, err = lookup(names.ordinals, value)
+			day++
+		case stdYearPrefix:
+			_, value, err = lookup([]string{names.yearPrefix}, value)
+		case stdUnderYearDay, stdZeroYearDay:
+			for i := 0; i < 2; i++ {
+				if std == stdUnderYearDay && len(value) > 0 && value[0] == ' ' {
+					value = value[1:]
+				}
+			}
+			yday, value, err = getnum3(value, std == stdZeroYearDay, zero)
+			// Day of year range is validated with the month and day below.
+		case stdWeek, stdZeroWeek:
+			week, value, err = getnum(value, std == stdZeroWeek, zero)
+			rangeErrOK = week < 1 || 54 < week
+		case stdQuarter:
+			quarter, value, err = getnum(value, false, zero)
+			rangeErrOK = quarter < 1 || 4 < quarter
+		case stdSeason:
+			season, value, err = lookup(names.seasons, value)
+		case stdEra, stdShortEra:
+			_, value, err = lookup([]string{names.era, names.shortEra}, value)
+		case stdLeap:
+			// The word is written only in leap years.
+			if _, rest, err := lookup([]string{names.leapYear}, value); err == nil {
+				value = rest
+			}
+		case stdQuoted:
+			value, err = skip(value, string(appendQuoted(nil, stdstr)))
+		case stdEzafe:
+			value, err = skipEzafe(value, names)
+		case stdFracSecond0, stdFracSecond9:
+			ndigit := std >> stdArgShift
+			if len(value) == 0 || value[0] != '.' {
+				if std&stdMask == stdFracSecond0 {
+					err = errBad
+				}
+				break
+			}
+			value = value[1:]
+			if std&stdMask == stdFracSecond0 {
+				nsec, value, err = parseNanoseconds(value, ndigit, zero)
+			} else {
+				nsec, value, err = parseNanoseconds(value, -1, zero)
+			}
+		}
+		if rangeErrOK {
+			return KurdishDate{}, &ErrorParse{Layout: alayout, Value: avalue, LayoutElem: stdstr, ValueElem: hold, Message: ": " + stdstr + " out of range"}
+		}
+		if err != nil {
+			return KurdishDate{}, &ErrorParse{Layout: alayout, Value: avalue, LayoutElem: stdstr, ValueElem: hold}
+		}
+	}
+	if pmSet && hour < 12 {
+		hour += 12
+	} else if amSet && hour == 12 {
+		hour = 0
+	}
+
+	if yday >= 0 {
+		if yday < 1 || yday > 366 {
+			return KurdishDate{}, &ErrorParse{Layout: alayout, Value: avalue, Message: ": day-of-year out of range"}
+		}
+		m, d := kurdishMonthDay(yday)
+		if month >= 0 && month != m {
+			return KurdishDate{}, &ErrorParse{Layout: alayout, Value: avalue, Message: ": day-of-year does not match month"}
+		}
+		if day >= 0 && day != d {
+			return KurdishDate{}, &ErrorParse{Layout: alayout, Value: avalue, Message: ": day-of-year does not match day"}
+		}
+		month, day = m, d
+	}
+	if month < 0 {
+		// A quarter or season without a month stands for its first month.
+		switch {
+		case quarter >= 0:
+			month = (quarter-1)*3 + 1
+		case season >= 0:
+			month = season*3 + 1
+		default:
+			month = 1
+		}
+	}
+	if day < 0 {
+		day = 1
+	}
+	gy, gm, gd, err := KurdishToGregorianDate(year, month, day, epoch)
+	if err != nil {
+		return KurdishDate{}, err
+	}
+	k, err := GregorianToKurdishDateE(gy, gm, gd, dialect, epoch)
+	if err != nil {
+		return KurdishDate{}, err
+	}
+	d := k.fields(names)
+	if week >= 0 && week != d.week() {
+		return KurdishDate{}, &ErrorParse{Layout: alayout, Value: avalue, Message: ": week does not match date"}
+	}
+	if quarter >= 0 && quarter != (month-1)/3+1 {
+		return KurdishDate{}, &ErrorParse{Layout: alayout, Value: avalue, Message: ": quarter does not match month"}
+	}
+	if season >= 0 && season != d.season() {
+		return KurdishDate{}, &ErrorParse{Layout: alayout, Value: avalue, Message: ": season does not match month"}
+	}
+	k.Hour, k.Minute, k.Second, k.Nanosecond = hour, min, sec, nsec
+	return k, nil
+}
+
+// errBad is used internally by the parser to report a mismatch; it is
+// always wrapped in an ErrorParse before being returned.
+var errBad = errors.New("bad value for field")
+
+// digitAt reports the value and encoded size of the digit at the start of s,
+// in the digit system starting at zero.
+func digitAt(s string, zero rune) (d int, size int, ok bool) {
+	r, size := utf8.DecodeRuneInString(s)
+	if r < zero || r > zero+9 {
+		return 0, 0, false
+	}
+	return int(r - zero), size, true
+}
+
+// getnum parses a one- or two-digit number from the beginning of s.
+// If fixed is set, exactly two digits are required.
+func getnum(s string, fixed bool, zero rune) (int, string, error) {
+	d, size, ok := digitAt(s, zero)
+	if !ok {
+		return 0, s, errBad
+	}
+	d2, size2, ok := digitAt(s[size:], zero)
+	if !ok {
+		if fixed {
+			return 0, s, errBad
+		}
+		return d, s[size:], nil
+	}
+	return d*10 + d2, s[size+size2:], nil
+}
+
+// getnum3 parses a one-, two- or three-digit number from the beginning of
+// s. If fixed is set, exactly three digits are required.
+func getnum3(s string, fixed bool, zero rune) (int, string, error) {
+	var n, i int
+	rest := s
+	for i = 0; i < 3; i++ {
+		d, size, ok := digitAt(rest, zero)
+		if !ok {
+			break
+		}
+		n = n*10 + d
+		rest = rest[size:]
+	}
+	if i == 0 || fixed && i != 3 {
+		return 0, s, errBad
+	}
+	return n, rest, nil
+}
+
+// getnumN parses exactly n digits from the beginning of s.
+func getnumN(s string, n int, zero rune) (int, string, error) {
+	x := 0
+	rest := s
+	for i := 0; i < n; i++ {
+		d, size, ok := digitAt(rest, zero)
+		if !ok {
+			return 0, s, errBad
+		}
+		x = x*10 + d
+		rest = rest[size:]
+	}
+	return x, rest, nil
+}
+
+// parseNanoseconds parses the digits of a fractional second from the
+// beginning of s. If n is negative, any number of digits is read;
+// otherwise exactly n digits are required.
+func parseNanoseconds(s string, n int, zero rune) (int, string, error) {
+	ns, digits := 0, 0
+	rest := s
+	for n < 0 || digits < n {
+		d, size, ok := digitAt(rest, zero)
+		if !ok {
+			if n >= 0 {
+				return 0, s, errBad
+			}
+			break
+		}
+		if digits < 9 {
+			ns = ns*10 + d
+		}
+		digits++
+		rest = rest[size:]
+	}
+	if n < 0 && digits == 0 {
+		return 0, s, errBad
+	}
+	for i := digits; i < 9; i++ {
+		ns *= 10
+	}
+	return ns, rest, nil
+}
+
+// lookup finds the longest name in tab that prefixes val and returns its
+// index and the remainder of val.
+func lookup(tab []string, val string) (int, string, error) {
+	best := -1
+	for i, v := range tab {
+		if v == "" || len(val) < len(v) || val[:len(v)] != v {
+			continue
+		}
+		if best < 0 || len(v) > len(tab[best]) {
+			best = i
+		}
+	}
+	if best < 0 {
+		return -1, val, errBad
+	}
+	return best, val[len(tab[best]):], nil
+}
+
+// skipEzafe removes an ezafe, in any of the forms written by appendEzafe,
+// from the beginning of value.
+func skipEzafe(value string, names *names) (string, error) {
+	forms := []string{names.ezafe, names.ezafeAfterVowel, string(zwnj) + names.ezafeAfterVowel}
+	_, value, err := lookup(forms, value)
+	return value, err
+}
+
+// skip removes the given prefix from value, treating runs of space
+// characters as equivalent.
+func skip(value, prefix string) (string, error) {
+	for len(prefix) > 0 {
+		if prefix[0] == ' ' {
+			if len(value) > 0 && value[0] != ' ' {
+				return value, errBad
+			}
+			prefix = cutspace(prefix)
+			value = cutspace(value)
+			continue
+		}
+		if len(value) == 0 || value[0] != prefix[0] {
+			return value, errBad
+		}
+		prefix = prefix[1:]
+		value = value[1:]
+	}
+	return value, nil
+}
+
+// cutspace removes leading space characters from s.
+func cutspace(s string) string {
+	for len(s) > 0 && s[0] == ' ' {
+		s = s[1:]
+	}
+	return s
+}
@@ -0,0 +1,221 @@
+package kurdical
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestKParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		layout   string
+		value    string
+		dialect  Dialect
+		epoch    Epoch
+		expected KurdishDate
+	}{
+		{
+			name:    "Numeric date",
+			layout:  "2006-01-02",
+			value:   "٢٧٢٣-٠١-٠١",
+			dialect: Sorani,
+			epoch:   MedianKingdom,
+			expected: KurdishDate{
+				Year:      2723,
+				Month:     1,
+				Day:       1,
+				Weekday:   4,
+				MonthName: "خاکه‌لێوه",
+				Dialect:   Sorani,
+				Epoch:     MedianKingdom,
+			},
+		},
+		{
+			name:    "Month name",
+			layout:  "2 January 2006",
+			value:   "١١ به‌فرانبار ٢٧٢٢",
+			dialect: Sorani,
+			epoch:   MedianKingdom,
+			expected: KurdishDate{
+				Year:      2722,
+				Month:     10,
+				Day:       11,
+				Weekday:   2,
+				MonthName: "به‌فرانبار",
+				Dialect:   Sorani,
+				Epoch:     MedianKingdom,
+			},
+		},
+		{
+			name:    "Longest month name wins",
+			layout:  "January 2006",
+			value:   "ماله‌ژیر دوماینه ٢٧٢٣",
+			dialect: Laki,
+			epoch:   MedianKingdom,
+			expected: KurdishDate{
+				Year:      2723,
+				Month:     7,
+				Day:       1,
+				Weekday:   1,
+				MonthName: "ماله‌ژیر دوماینه",
+				Dialect:   Laki,
+				Epoch:     MedianKingdom,
+			},
+		},
+		{
+			name:    "Weekday, two-digit year and clock",
+			layout:  "Monday 02/01/06 15:04:05 PM",
+			value:   "سێ‌شەم ٠١/٠١/٣٥ ١٣:٠٠:٠٠ پشتی نیڤرۆ",
+			dialect: Kurmanji,
+			epoch:   FallOfNineveh,
+			expected: KurdishDate{
+				Year:      2635,
+				Month:     1,
+				Day:       1,
+				Weekday:   4,
+				MonthName: "نیسان",
+				Dialect:   Kurmanji,
+				Epoch:     FallOfNineveh,
+				Hour:      13,
+			},
+		},
+		{
+			name:    "Leap day",
+			layout:  "2006-01-02",
+			value:   "٢٧٢٤-١٢-٣٠",
+			dialect: Sorani,
+			epoch:   MedianKingdom,
+			expected: KurdishDate{
+				Year:      2724,
+				Month:     12,
+				Day:       30,
+				Weekday:   6,
+				MonthName: "ره‌شه‌مێ",
+				Dialect:   Sorani,
+				Epoch:     MedianKingdom,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := KParse(tt.layout, tt.value, tt.dialect, tt.epoch)
+			if err != nil {
+				t.Fatalf("KParse() unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("KParse() = %v, expected %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestKParseErrors(t *testing.T) {
+	var (
+		parseErr *ErrorParse
+		monthErr *ErrorInvalidMonth
+		dayErr   *ErrorInvalidDay
+		yearErr  *ErrorInvalidYear
+	)
+	tests := []struct {
+		name   string
+		layout string
+		value  string
+		target interface{}
+	}{
+		{"Latin digits", "2006-01-02", "2723-01-01", &parseErr},
+		{"Literal mismatch", "2006-01-02", "٢٧٢٣/٠١/٠١", &parseErr},
+		{"Extra text", "2006-01-02", "٢٧٢٣-٠١-٠١ ", &parseErr},
+		{"Unknown month name", "January 2006", "نیسان ٢٧٢٣", &parseErr},
+		{"Hour out of range", "2006-01-02 15", "٢٧٢٣-٠١-٠١ ٢٤", &parseErr},
+		{"Month out of range", "2006-01-02", "٢٧٢٣-١٣-٠١", &monthErr},
+		{"Day out of range", "2006-01-02", "٢٧٢٣-٠٧-٣١", &dayErr},
+		{"Non-leap day 30", "2006-01-02", "٢٧٢٥-١٢-٣٠", &dayErr},
+		{"Year out of range", "2006-01-02", "٩٩٩٩-٠١-٠١", &yearErr},
+		{"Quoted text mismatch", "'No. 1' 2006", "No. ١ ٢٧٢٣", &parseErr},
+		{"Day of year mismatch", "2006-01-02 002", "٢٧٢٣-٠١-٠١ ٠٠٢", &parseErr},
+		{"Day of year out of range", "2006 002", "٢٧٢٣ ٣٦٧", &parseErr},
+		{"Day 366 of a common year", "2006 002", "٢٧٢٣ ٣٦٦", &dayErr},
+		{"Week out of range", "2006 W01", "٢٧٢٣ ٥٥", &parseErr},
+		{"Quarter out of range", "2006 Q1", "٢٧٢٣ ٥", &parseErr},
+		{"Unknown season", "2006 Spring", "٢٧٢٣ بهار", &parseErr},
+		{"Week mismatch", "2006-01-02 W01", "٢٧٢٣-٠١-٠١ ٠٢", &parseErr},
+		{"Quarter mismatch", "2006-01-02 Q1", "٢٧٢٣-٠١-٠١ ٢", &parseErr},
+		{"Season mismatch", "2006-01-02 Spring", "٢٧٢٣-٠١-٠١ هاوین", &parseErr},
+		{"Gregorian era", "2006 Era", "٢٧٢٣ زایینی", &parseErr},
+		{"Missing ezafe", "2ـی January 2006", "١ خاکه\u200cلێوه ٢٧٢٣", &parseErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := KParse(tt.layout, tt.value, Sorani, MedianKingdom)
+			if err == nil {
+				t.Fatalf("KParse() expected error, got none")
+			}
+			if !errors.As(err, tt.target) {
+				t.Errorf("KParse() error = %T (%v), expected %T", err, err, tt.target)
+			}
+		})
+	}
+}
+
+func TestKParseRoundTrip(t *testing.T) {
+	layouts := []string{
+		"2006-01-02",
+		"2006/01/02 January",
+		"02 Jan 2006",
+		"Monday, 02 January 2006",
+		"_2 January 06",
+	}
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, d := range []Dialect{Laki, Hawrami, Sorani, Kalhuri, Kurmanji} {
+		for i := 0; i < 800; i += 7 {
+			k := GregorianToKurdish(start.AddDate(0, 0, i), d, MedianKingdom)
+			for _, layout := range layouts {
+				s, err := k.KFormat(layout)
+				if err != nil {
+					t.Fatalf("KFormat(%q) unexpected error: %v", layout, err)
+				}
+				got, err := KParse(layout, s, d, MedianKingdom)
+				if err != nil {
+					t.Fatalf("KParse(%q, %q) unexpected error: %v", layout, s, err)
+				}
+				if got != k {
+					t.Errorf("KParse(%q, %q) = %v, expected %v", layout, s, got, k)
+				}
+			}
+		}
+	}
+}
+
+func TestKParseClock(t *testing.T) {
+	tests := []struct {
+		layout string
+		value  string
+		hour   int
+		minute int
+		second int
+		nsec   int
+	}{
+		{"2006-01-02 15:04:05", "٢٧٢٣-٠١-٠١ ١٣:٠٤:٠٥", 13, 4, 5, 0},
+		{"2006-01-02 3:04 PM", "٢٧٢٣-٠١-٠١ ١:٠٤ دوای نیوەڕۆ", 13, 4, 0, 0},
+		{"2006-01-02 3:04 PM", "٢٧٢٣-٠١-٠١ ١٢:٠٤ پێش نیوەڕۆ", 0, 4, 0, 0},
+		{"2006-01-02 15:04:05.000", "٢٧٢٣-٠١-٠١ ١٣:٠٤:٠٥.١٢٠", 13, 4, 5, 120000000},
+		{"2006-01-02 15:04:05.999", "٢٧٢٣-٠١-٠١ ١٣:٠٤:٠٥.١٢", 13, 4, 5, 120000000},
+		{"2006-01-02 15:04:05.999", "٢٧٢٣-٠١-٠١ ١٣:٠٤:٠٥", 13, 4, 5, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			k, err := KParse(tt.layout, tt.value, Sorani, MedianKingdom)
+			if err != nil {
+				t.Fatalf("KParse() unexpected error: %v", err)
+			}
+			if k.Hour != tt.hour || k.Minute != tt.minute || k.Second != tt.second || k.Nanosecond != tt.nsec {
+				t.Errorf("KParse() time = %02d:%02d:%02d.%09d, expected %02d:%02d:%02d.%09d",
+					k.Hour, k.Minute, k.Second, k.Nanosecond, tt.hour, tt.minute, tt.second, tt.nsec)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package kurdical
+
+// Period represents a duration in Kurdish calendar years, months and days.
+//
+// Periods returned by Between are normalized: all fields have the same sign,
+// Months is within ±11 and Days is shorter than the month it ends in.
+type Period struct {
+	Years  int
+	Months int
+	Days   int
+}
+
+// Between returns the period from a to b, such that adding its months
+// (with AddMonths, which also counts years as 12 months) and then its days
+// to a gives b. The period is negative if b is before a.
+//
+// The two dates may use different epochs and calendar models; b is
+// counted in the model of a. Time of day is ignored.
+// It returns the zero Period if a or b is not a valid date;
+// use BetweenE to get the reason.
+func Between(a, b KurdishDate) Period {
+	p, _ := BetweenE(a, b)
+	return p
+}
+
+// BetweenE is like Between but returns an error if a or b is not a valid
+// date, as AddMonths does.
+func BetweenE(a, b KurdishDate) (Period, error) {
+	if _, err := a.julianDay(); err != nil {
+		return Period{}, err
+	}
+	jdn, err := b.julianDay()
+	if err != nil {
+		return Period{}, err
+	}
+	by, bm, bd := b.Year-epochOffsets[b.Epoch], b.Month, b.Day
+	if b.Model != a.Model {
+		if by, bm, bd, err = fromJDN(jdn, a.Model); err != nil {
+			return Period{}, &ErrorInvalidYear{Year: b.Year}
+		}
+	}
+	return between(a.Year-epochOffsets[a.Epoch], a.Month, a.Day, by, bm, bd, a.Model), nil
+}
+
+// between returns the period between two valid Solar Hijri dates in the
+// same calendar model.
+func between(ay, am, ad, by, bm, bd int, model CalendarModel) Period {
+	if compareYMD(ay, am, ad, by, bm, bd) > 0 {
+		return between(by, bm, bd, ay, am, ad, model).Neg()
+	}
+
+	months := (by*12 + bm) - (ay*12 + am)
+	cy, cm, cd := addMonthsYMD(ay, am, ad, months, model)
+	if compareYMD(cy, cm, cd, by, bm, bd) > 0 {
+		months--
+		cy, cm, cd = addMonthsYMD(ay, am, ad, months, model)
+	}
+
+	// The candidate is now in the same month as b or in the month before it.
+	days := bd - cd
+	if cy != by || cm != bm {
+		days += daysInMonth(cy, cm, model)
+	}
+	return Period{Years: months / 12, Months: months % 12, Days: days}
+}
+
+// Neg returns the period with every field negated.
+func (p Period) Neg() Period {
+	return Period{Years: -p.Years, Months: -p.Months, Days: -p.Days}
+}
+
+// IsZero reports whether p is the empty period.
+func (p Period) IsZero() bool {
+	return p.Years == 0 && p.Months == 0 && p.Days == 0
+}
+
+// Normalized returns p with whole years carried out of Months, so that Months
+// is within ±11. Days are left unchanged, since their length in months
+// depends on the date the period is applied to.
+func (p Period) Normalized() Period {
+	months := p.Years*12 + p.Months
+	return Period{Years: months / 12, Months: months % 12, Days: p.Days}
+}
+
+// Format renders p in the given dialect with its default digits, for
+// example "٣ ساڵ و ٢ مانگ و ٥ ڕۆژ" in Sorani. An unknown dialect is
+// rendered in Sorani. Zero fields are left out, and the
+// empty period is rendered as zero days. A negative period is prefixed
+// with a minus sign.
+func (p Period) Format(dialect Dialect) string {
+	return p.FormatWith(dialect, FormatOptions{})
+}
+
+// FormatWith is like Format but writes the period as set by opts, as
+// KFormatWith does: the unit words in opts.Script and opts.Orthography
+// and the numbers with opts.Digits, as in "3 sal û 2 meh û 5 roj" for
+// Kurmanji in Latin script. A dialect with no unit words in opts.Script
+// writes the period in its own script, with the digits of that script.
+func (p Period) FormatWith(dialect Dialect, opts FormatOptions) string {
+	loc := lookupLocaleOrDefault(dialect)
+	names, zero := loc.resolve(opts)
+	if names.periodUnits[0] == "" {
+		opts.Script, opts.Digits = loc.script, DefaultDigits
+		names, zero = loc.resolve(opts)
+	}
+	units := names.periodUnits
+	values := [3]int{p.Years, p.Months, p.Days}
+
+	b := make([]byte, 0, 64)
+	if p.Years < 0 || p.Months < 0 || p.Days < 0 {
+		b = append(b, '-')
+	}
+	first := true
+	for i, v := range values {
+		if v == 0 {
+			continue
+		}
+		if v < 0 {
+			v = -v
+		}
+		if !first {
+			b = append(b, names.periodSeparator...)
+		}
+		first = false
+		b = appendInt(b, v, 0, zero)
+		b = append(b, ' ')
+		b = append(b, units[i]...)
+	}
+	if p.IsZero() {
+		b = appendInt(b, 0, 0, zero)
+		b = append(b, ' ')
+		b = append(b, units[2]...)
+	}
+	return string(b)
+}
+
+// addMonthsYMD adds n months to a Solar Hijri date, clamping the day to the
+// length of the resulting month as AddMonths does.
+func addMonthsYMD(sYear, month, day, n int, model CalendarModel) (int, int, int) {
+	total := sYear*12 + month - 1 + n
+	sYear, month = floorDiv(total, 12), floorMod(total, 12)+1
+	if last := daysInMonth(sYear, month, model); day > last {
+		day = last
+	}
+	return sYear, month, day
+}
@@ -0,0 +1,202 @@
+package kurdical
+
+import (
+	"testing"
+)
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        KurdishDate
+		b        KurdishDate
+		expected Period
+	}{
+		{
+			name:     "Same date",
+			a:        KurdishDate{Year: 2723, Month: 5, Day: 10, Epoch: MedianKingdom},
+			b:        KurdishDate{Year: 2723, Month: 5, Day: 10, Epoch: MedianKingdom},
+			expected: Period{},
+		},
+		{
+			name:     "Years, months and days",
+			a:        KurdishDate{Year: 2720, Month: 3, Day: 5, Epoch: MedianKingdom},
+			b:        KurdishDate{Year: 2723, Month: 5, Day: 10, Epoch: MedianKingdom},
+			expected: Period{Years: 3, Months: 2, Days: 5},
+		},
+		{
+			name:     "Borrow days from previous month",
+			a:        KurdishDate{Year: 2723, Month: 1, Day: 20, Epoch: MedianKingdom},
+			b:        KurdishDate{Year: 2723, Month: 3, Day: 5, Epoch: MedianKingdom},
+			expected: Period{Months: 1, Days: 16},
+		},
+		{
+			name:     "Day 31 clamped into 30-day month",
+			a:        KurdishDate{Year: 2723, Month: 6, Day: 31, Epoch: MedianKingdom},
+			b:        KurdishDate{Year: 2723, Month: 8, Day: 1, Epoch: MedianKingdom},
+			expected: Period{Months: 1, Days: 1},
+		},
+		{
+			name:     "Across year end",
+			a:        KurdishDate{Year: 2723, Month: 12, Day: 29, Epoch: MedianKingdom},
+			b:        KurdishDate{Year: 2724, Month: 1, Day: 1, Epoch: MedianKingdom},
+			expected: Period{Days: 1},
+		},
+		{
+			name:     "Different epochs",
+			a:        KurdishDate{Year: 2635, Month: 1, Day: 1, Epoch: FallOfNineveh},
+			b:        KurdishDate{Year: 2724, Month: 2, Day: 1, Epoch: MedianKingdom},
+			expected: Period{Years: 1, Months: 1},
+		},
+		{
+			name:     "Negative",
+			a:        KurdishDate{Year: 2723, Month: 5, Day: 10, Epoch: MedianKingdom},
+			b:        KurdishDate{Year: 2720, Month: 3, Day: 5, Epoch: MedianKingdom},
+			expected: Period{Years: -3, Months: -2, Days: -5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Between(tt.a, tt.b)
+			if result != tt.expected {
+				t.Errorf("Between() = %+v, expected %+v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBetweenE(t *testing.T) {
+	valid := KurdishDate{Year: 2723, Month: 1, Day: 1, Epoch: MedianKingdom}
+	tests := []struct {
+		name     string
+		a        KurdishDate
+		b        KurdishDate
+		expected Period
+		wantErr  bool
+	}{
+		{
+			name:     "Different models",
+			a:        valid,
+			b:        KurdishDate{Year: 2723, Month: 1, Day: 5, Epoch: MedianKingdom, Model: Astronomical},
+			expected: Period{Days: 4},
+		},
+		{
+			name:    "Month 0",
+			a:       valid,
+			b:       KurdishDate{Year: 2723, Month: 0, Day: 1, Epoch: MedianKingdom},
+			wantErr: true,
+		},
+		{
+			name:    "Day 31 in month 7",
+			a:       KurdishDate{Year: 2723, Month: 7, Day: 31, Epoch: MedianKingdom},
+			b:       valid,
+			wantErr: true,
+		},
+		{
+			name:    "Unknown epoch",
+			a:       valid,
+			b:       KurdishDate{Year: 2723, Month: 1, Day: 1, Epoch: Epoch(9)},
+			wantErr: true,
+		},
+		{
+			name:    "Unknown model",
+			a:       KurdishDate{Year: 2723, Month: 1, Day: 1, Epoch: MedianKingdom, Model: CalendarModel(9)},
+			b:       valid,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := BetweenE(tt.a, tt.b)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BetweenE() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if result != tt.expected {
+				t.Errorf("BetweenE() = %+v, expected %+v", result, tt.expected)
+			}
+			if p := Between(tt.a, tt.b); p != result {
+				t.Errorf("Between() = %+v, expected %+v", p, result)
+			}
+		})
+	}
+}
+
+func TestBetweenAddsBack(t *testing.T) {
+	a := KurdishDate{Year: 2722, Month: 6, Day: 31, Dialect: Sorani, Epoch: MedianKingdom}
+	for i := 0; i < 800; i += 3 {
+		b, err := a.AddDays(i)
+		if err != nil {
+			t.Fatalf("AddDays() unexpected error: %v", err)
+		}
+		p := Between(a, b)
+		r, err := a.AddMonths(p.Years*12 + p.Months)
+		if err != nil {
+			t.Fatalf("AddMonths() unexpected error: %v", err)
+		}
+		if r, err = r.AddDays(p.Days); err != nil {
+			t.Fatalf("AddDays() unexpected error: %v", err)
+		}
+		if r != b {
+			t.Errorf("Between(%v, %v) = %+v, which adds back to %v", a, b, p, r)
+		}
+	}
+}
+
+func TestPeriodNormalized(t *testing.T) {
+	p := Period{Years: 1, Months: 14, Days: 40}.Normalized()
+	if p != (Period{Years: 2, Months: 2, Days: 40}) {
+		t.Errorf("Normalized() = %+v, expected {2 2 40}", p)
+	}
+}
+
+func TestPeriodFormat(t *testing.T) {
+	tests := []struct {
+		period   Period
+		dialect  Dialect
+		expected string
+	}{
+		{Period{Years: 3, Months: 2, Days: 5}, Sorani, "٣ ساڵ و ٢ مانگ و ٥ ڕۆژ"},
+		{Period{Years: 3, Days: 5}, Kurmanji, "٣ سال و ٥ ڕۆژ"},
+		{Period{Months: 1}, Hawrami, "١ مانگ"},
+		{Period{}, Laki, "٠ ڕووژ"},
+		{Period{Years: -1, Months: -6}, Kalhuri, "-١ سال و ٦ مانگ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if result := tt.period.Format(tt.dialect); result != tt.expected {
+				t.Errorf("Format() = %q, expected %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPeriodFormatWith(t *testing.T) {
+	feyli, err := RegisterDialect(feyliLocale(uniqueName("Feyli")))
+	if err != nil {
+		t.Fatalf("RegisterDialect() unexpected error: %v", err)
+	}
+	p := Period{Years: 3, Months: 2}
+	tests := []struct {
+		dialect  Dialect
+		opts     FormatOptions
+		expected string
+	}{
+		{Kurmanji, FormatOptions{}, "٣ سال و ٢ مەھ"},
+		{Kurmanji, FormatOptions{Orthography: LegacyOrthography}, "٣ سال و ٢ مه\u200cھ"},
+		{Kurmanji, FormatOptions{Digits: LatinDigits, Orthography: StandardOrthography}, "3 سال و 2 مەھ"},
+		{Kurmanji, FormatOptions{Script: LatinScript}, "3 sal û 2 meh"},
+		{Sorani, FormatOptions{Script: LatinScript, Digits: EasternArabicDigits}, "٣ sal û ٢ mang"},
+		// A dialect with no Latin names writes the period in its own script.
+		{feyli, FormatOptions{Script: LatinScript}, "٣ ساڵ و ٢ مانگ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if result := p.FormatWith(tt.dialect, tt.opts); result != tt.expected {
+				t.Errorf("FormatWith() = %q, expected %q", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package kurdical
+
+// strftimeStd maps strftime directives to the layout tokens that write them.
+var strftimeStd = map[byte]int{
+	'Y': stdLongYear,
+	'y': stdYear,
+	'm': stdZeroMonth,
+	'B': stdLongMonth,
+	'b': stdMonth,
+	'h': stdMonth,
+	'd': stdZeroDay,
+	'e': stdUnderDay,
+	'o': stdOrdinalDay,
+	'j': stdZeroYearDay,
+	'J': stdZeroWeek,
+	'q': stdQuarter,
+	'A': stdLongWeekDay,
+	'a': stdWeekDay,
+	'H': stdHour,
+	'I': stdZeroHour12,
+	'M': stdZeroMinute,
+	'S': stdZeroSecond,
+	'p': stdPM,
+	'E': stdEra,
+}
+
+// strftimeUnpadded maps the directives that accept the "-" flag to the
+// layout tokens that write them without padding.
+var strftimeUnpadded = map[byte]int{
+	'm': stdNumMonth,
+	'd': stdDay,
+	'J': stdWeek,
+	'I': stdHour12,
+	'M': stdMinute,
+	'S': stdSecond,
+}
+
+// strftimeComposite holds the directives that stand for other directives.
+var strftimeComposite = map[byte]string{
+	'F': "%Y-%m-%d",
+	'T': "%H:%M:%S",
+	'R': "%H:%M",
+}
+
+// Strftime formats the date with a strftime-style format, as an
+// alternative to the reference layouts of KFormat. It writes the same
+// names and digits as KFormat; for example "%A %d %B %Y" gives
+// "سێ‌شەممە ٠١ خاکه‌لێوه ٢٧٢٣".
+//
+// The supported directives are:
+//
+//	%Y  year                       %y  year in two digits
+//	%m  month, 01–12               %d  day of the month, 01–31
+//	%B  month name in the dialect  %b  abbreviated month name (also %h)
+//	%A  weekday name               %a  abbreviated weekday name
+//	%e  day, padded with a space   %j  day of the year, 001–366
+//	%q  quarter, 1–4               %p  the word for AM or PM
+//	%H  hour, 00–23                %I  hour, 01–12
+//	%M  minute, 00–59              %S  second, 00–59
+//	%F  same as %Y-%m-%d           %T  same as %H:%M:%S
+//	%R  same as %H:%M              %n  newline
+//	%t  tab                        %%  a literal %
+//
+// The flag "-", as in "%-d", writes %m, %d, %J, %I, %M and %S without
+// padding.
+//
+// Kurdish extensions are:
+//
+//	%o  day as an ordinal number, such as "یەکەم"
+//	%J  week of the year, 01–54; weeks begin on Saturday, and week 1 is
+//	    the week of the first day of the year
+//	%E  era, such as "کوردی"
+//	%K  write the numbers that follow in Kurdish digits
+//	%L  write the numbers that follow in Latin digits
+//
+// The POSIX week directives %U, %W and %V, whose weeks begin on Sunday
+// or Monday, are not supported. An unknown or incomplete directive gives
+// an *ErrorInvalidDirective.
+func (k KurdishDate) Strftime(format string) (string, error) {
+	return k.StrftimeWith(format, FormatOptions{})
+}
+
+// StrftimeWith is like Strftime but writes the date as set by opts, as
+// KFormatWith does.
+func (k KurdishDate) StrftimeWith(format string, opts FormatOptions) (string, error) {
+	loc := lookupLocaleOrDefault(k.Dialect)
+	names, zero := loc.resolve(opts)
+	d := k.fields(names)
+
+	// Kurdish digits are those of the dialect in Arabic script.
+	kurdish := loc.digits
+	if kurdish == DefaultDigits || kurdish == LatinDigits {
+		kurdish = EasternArabicDigits
+	}
+	s := strftimeState{format: format, d: &d, names: names, zero: zero, kurdish: kurdish.zero()}
+	b, err := s.append(make([]byte, 0, 64), format)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// strftimeState holds what Strftime writes with, which the %K and %L
+// directives change as the format is read.
+type strftimeState struct {
+	format  string // the whole format, for errors
+	d       *calendarDate
+	names   *names
+	zero    rune
+	kurdish rune
+}
+
+// append appends the date formatted by format to b and returns the
+// extended buffer.
+func (s *strftimeState) append(b []byte, format string) ([]byte, error) {
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		if c != '%' {
+			b = append(b, c)
+			continue
+		}
+		start := i
+		i++
+		unpadded := i < len(format) && format[i] == '-'
+		if unpadded {
+			i++
+		}
+		if i == len(format) {
+			return b, &ErrorInvalidDirective{Format: s.format, Directive: format[start:]}
+		}
+		c = format[i]
+		directive := format[start : i+1]
+
+		if unpadded {
+			std, ok := strftimeUnpadded[c]
+			if !ok {
+				return b, &ErrorInvalidDirective{Format: s.format, Directive: directive}
+			}
+			b = appendStd(b, std, directive, s.d, s.names, s.zero)
+			continue
+		}
+		if std, ok := strftimeStd[c]; ok {
+			b = appendStd(b, std, directive, s.d, s.names, s.zero)
+			continue
+		}
+		var err error
+		switch c {
+		case '%':
+			b = append(b, '%')
+		case 'n':
+			b = append(b, '\n')
+		case 't':
+			b = append(b, '\t')
+		case 'K':
+			s.zero = s.kurdish
+		case 'L':
+			s.zero = '0'
+		case 'F', 'T', 'R':
+			b, err = s.append(b, strftimeComposite[c])
+		default:
+			err = &ErrorInvalidDirective{Format: s.format, Directive: directive}
+		}
+		if err != nil {
+			return b, err
+		}
+	}
+	return b, nil
+}
@@ -0,0 +1,120 @@
+package kurdical
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestStrftime(t *testing.T) {
+	// 2723/1/1 is a Tuesday.
+	k := GregorianToKurdish(time.Date(2023, 3, 21, 15, 4, 5, 0, time.UTC), Sorani, MedianKingdom)
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{"%Y-%m-%d", "٢٧٢٣-٠١-٠١"},
+		{"%F %T", "٢٧٢٣-٠١-٠١ ١٥:٠٤:٠٥"},
+		{"%A %d %B %Y", "سێ‌شەممە ٠١ خاکه‌لێوه ٢٧٢٣"},
+		{"%a %b %y", "س خاک ٢٣"},
+		{"%-d/%-m %e", "١/١  ١"},
+		{"%I:%M %p", "٠٣:٠٤ دوای نیوەڕۆ"},
+		{"%-I:%-M:%-S %R", "٣:٤:٥ ١٥:٠٤"},
+		{"%j %J %-J %q", "٠٠١ ٠١ ١ ١"},
+		{"%o %E", "یەکەم کوردی"},
+		{"%L%Y-%m-%d %K%Y", "2723-01-01 ٢٧٢٣"},
+		{"%L%F%n%t100%%", "2723-01-01\n\t100%"},
+		{"2006 January", "2006 January"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			result, err := k.Strftime(tt.format)
+			if err != nil {
+				t.Fatalf("Strftime() unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Strftime() = %q, expected %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStrftimeWith(t *testing.T) {
+	k := GregorianToKurdish(time.Date(2023, 3, 21, 0, 0, 0, 0, time.UTC), Kurmanji, MedianKingdom)
+	tests := []struct {
+		name     string
+		format   string
+		opts     FormatOptions
+		expected string
+	}{
+		{"Latin script", "%A, %-d %B %Y %E", FormatOptions{Script: LatinScript}, "Sêşem, 1 Nîsan 2723 Kurdî"},
+		{"Kurdish digits in Latin script", "%B %K%Y", FormatOptions{Script: LatinScript}, "Nîsan ٢٧٢٣"},
+		{"Persian digits", "%F", FormatOptions{Digits: PersianDigits}, "۲۷۲۳-۰۱-۰۱"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := k.StrftimeWith(tt.format, tt.opts)
+			if err != nil {
+				t.Fatalf("StrftimeWith() unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("StrftimeWith() = %q, expected %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStrftimeErrors(t *testing.T) {
+	k := GregorianToKurdish(time.Date(2023, 3, 21, 0, 0, 0, 0, time.UTC), Sorani, MedianKingdom)
+	tests := []struct {
+		format    string
+		directive string
+	}{
+		{"%Y-%Z", "%Z"},
+		{"%Y %", "%"},
+		{"%-", "%-"},
+		{"%-B", "%-B"},
+		{"%j %U", "%U"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			_, err := k.Strftime(tt.format)
+			var directiveErr *ErrorInvalidDirective
+			if !errors.As(err, &directiveErr) {
+				t.Fatalf("Strftime() error = %v, expected ErrorInvalidDirective", err)
+			}
+			if directiveErr.Directive != tt.directive {
+				t.Errorf("Strftime() directive = %q, expected %q", directiveErr.Directive, tt.directive)
+			}
+		})
+	}
+}
+
+func TestStrftimeMatchesKFormat(t *testing.T) {
+	k := GregorianToKurdish(time.Date(2024, 1, 9, 21, 30, 0, 0, time.UTC), Hawrami, FallOfNineveh)
+	pairs := []struct {
+		format string
+		layout string
+	}{
+		{"%Y/%m/%d %H:%M:%S", "2006/01/02 15:04:05"},
+		{"%A %e %B %y", "Monday _2 January 06"},
+		{"%a %b %I %p", "Mon Jan 03 PM"},
+		{"%j %J %q %o", "002 W01 Q1 2nd"},
+	}
+
+	for _, tt := range pairs {
+		t.Run(tt.format, func(t *testing.T) {
+			got, err := k.Strftime(tt.format)
+			if err != nil {
+				t.Fatalf("Strftime() unexpected error: %v", err)
+			}
+			want, _ := k.KFormat(tt.layout)
+			if got != want {
+				t.Errorf("Strftime(%q) = %q, KFormat(%q) = %q", tt.format, got, tt.layout, want)
+			}
+		})
+	}
+}